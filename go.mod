@@ -9,7 +9,7 @@ require (
 	github.com/golang/protobuf v1.3.1 // indirect
 	github.com/google/btree v1.0.0 // indirect
 	github.com/google/go-cmp v0.3.0 // indirect
-	github.com/google/go-containerregistry v0.0.0-20190503220729-1c6c7f61e8a5 // indirect
+	github.com/google/go-containerregistry v0.0.0-20190503220729-1c6c7f61e8a5
 	github.com/google/gofuzz v1.0.0 // indirect
 	github.com/google/licenseclassifier v0.0.0-20190501212618-47b603fe1b8c // indirect
 	github.com/googleapis/gnostic v0.2.0 // indirect
@@ -19,7 +19,7 @@ require (
 	github.com/inconshreveable/mousetrap v1.0.0 // indirect
 	github.com/json-iterator/go v1.1.6 // indirect
 	github.com/jstemmer/go-junit-report v0.0.0-20190106144839-af01ea7f8024 // indirect
-	github.com/knative/build v0.6.0 // indirect
+	github.com/knative/build v0.6.0
 	github.com/knative/pkg v0.0.0-20190518173526-34792a92cec2
 	github.com/knative/serving v0.6.0
 	github.com/knative/test-infra v0.0.0-20190531180034-a3c073a2fea1
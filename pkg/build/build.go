@@ -0,0 +1,231 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package build
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	buildv1alpha1 "github.com/knative/build/pkg/apis/build/v1alpha1"
+	duckv1alpha1 "github.com/knative/pkg/apis/duck/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+)
+
+// Resources of Knative Build, which are accessed with the dynamic client
+var (
+	BuildResource         = buildv1alpha1.SchemeGroupVersion.WithResource("builds")
+	BuildTemplateResource = buildv1alpha1.SchemeGroupVersion.WithResource("buildtemplates")
+)
+
+// Create a build which checks out the given git repository and runs
+// the given build template with the given arguments
+func NewGitBuild(namespace, name, gitURL, gitRevision, template string, args map[string]string) *buildv1alpha1.Build {
+	build := &buildv1alpha1.Build{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: buildv1alpha1.SchemeGroupVersion.String(),
+			Kind:       "Build",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: buildv1alpha1.BuildSpec{
+			Source: &buildv1alpha1.SourceSpec{
+				Git: &buildv1alpha1.GitSourceSpec{
+					Url:      gitURL,
+					Revision: gitRevision,
+				},
+			},
+			Template: &buildv1alpha1.TemplateInstantiationSpec{
+				Name: template,
+				Kind: buildv1alpha1.BuildTemplateKind,
+			},
+		},
+	}
+
+	names := make([]string, 0, len(args))
+	for name := range args {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		build.Spec.Template.Arguments = append(build.Spec.Template.Arguments,
+			buildv1alpha1.ArgumentSpec{Name: name, Value: args[name]})
+	}
+	return build
+}
+
+// Convert a build to its unstructured form as used by the dynamic client
+func ToUnstructured(build *buildv1alpha1.Build) (*unstructured.Unstructured, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(build)
+	if err != nil {
+		return nil, err
+	}
+	return &unstructured.Unstructured{Object: content}, nil
+}
+
+// Convert an unstructured object as returned by the dynamic client to a build
+func FromUnstructured(obj *unstructured.Unstructured) (*buildv1alpha1.Build, error) {
+	build := &buildv1alpha1.Build{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, build)
+	if err != nil {
+		return nil, err
+	}
+	return build, nil
+}
+
+// Convert an unstructured list as returned by the dynamic client to a build list
+func ListFromUnstructured(list *unstructured.UnstructuredList) (*buildv1alpha1.BuildList, error) {
+	builds := &buildv1alpha1.BuildList{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(list.UnstructuredContent(), builds)
+	if err != nil {
+		return nil, err
+	}
+	return builds, nil
+}
+
+// Convert an unstructured list as returned by the dynamic client to a
+// build template list
+func TemplateListFromUnstructured(list *unstructured.UnstructuredList) (*buildv1alpha1.BuildTemplateList, error) {
+	templates := &buildv1alpha1.BuildTemplateList{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(list.UnstructuredContent(), templates)
+	if err != nil {
+		return nil, err
+	}
+	return templates, nil
+}
+
+// Wait until the given build has finished, printing the status of each
+// build step when it changes. An error is returned if the build fails or
+// doesn't finish within the timeout.
+func WaitForBuild(client dynamic.Interface, namespace, name string, timeout time.Duration, out io.Writer) error {
+	watcher, err := client.Resource(BuildResource).Namespace(namespace).Watch(metav1.ListOptions{
+		FieldSelector: "metadata.name=" + name,
+	})
+	if err != nil {
+		return err
+	}
+	defer watcher.Stop()
+
+	reported := map[string]string{}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for {
+		select {
+		case <-timer.C:
+			return fmt.Errorf("timeout: build '%s' not finished after %v", name, timeout)
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return fmt.Errorf("watch for build '%s' closed unexpectedly", name)
+			}
+			if event.Type == watch.Error {
+				return fmt.Errorf("watch for build '%s' failed: %v", name, event.Object)
+			}
+			if event.Type == watch.Deleted {
+				return fmt.Errorf("build '%s' has been deleted", name)
+			}
+			obj, ok := event.Object.(*unstructured.Unstructured)
+			if !ok || obj.GetName() != name {
+				continue
+			}
+			build, err := FromUnstructured(obj)
+			if err != nil {
+				return err
+			}
+			printStepChanges(build, reported, out)
+			done, err := isBuildDone(build)
+			if done {
+				return err
+			}
+		}
+	}
+}
+
+// Name of the step at the given index. Only the names of completed steps
+// are reported by the build status.
+func StepName(build *buildv1alpha1.Build, index int) string {
+	if index < len(build.Status.StepsCompleted) {
+		return build.Status.StepsCompleted[index]
+	}
+	return fmt.Sprintf("step-%d", index)
+}
+
+// Name of the build step which runs in the given init container of the
+// build's pod
+func StepContainerStepName(container string) string {
+	return strings.TrimPrefix(container, stepContainerPrefix)
+}
+
+// Get a short description of a build step's state
+func StepStatus(state corev1.ContainerState) string {
+	switch {
+	case state.Terminated != nil && state.Terminated.ExitCode == 0:
+		return "Completed"
+	case state.Terminated != nil:
+		return fmt.Sprintf("Failed (%s)", state.Terminated.Reason)
+	case state.Running != nil:
+		return "Running"
+	case state.Waiting != nil && state.Waiting.Reason != "":
+		return fmt.Sprintf("Waiting (%s)", state.Waiting.Reason)
+	default:
+		return "Waiting"
+	}
+}
+
+// Get the Succeeded condition of the build, if any
+func SucceededCondition(build *buildv1alpha1.Build) *duckv1alpha1.Condition {
+	return build.Status.GetCondition(buildv1alpha1.BuildSucceeded)
+}
+
+// =======================================================================================
+
+// Prefix of the init containers running the build steps
+const stepContainerPrefix = "build-step-"
+
+func printStepChanges(build *buildv1alpha1.Build, reported map[string]string, out io.Writer) {
+	for i, state := range build.Status.StepStates {
+		step := StepName(build, i)
+		status := StepStatus(state)
+		if reported[step] != status {
+			reported[step] = status
+			fmt.Fprintf(out, "Build step '%s': %s\n", step, status)
+		}
+	}
+}
+
+func isBuildDone(build *buildv1alpha1.Build) (bool, error) {
+	cond := SucceededCondition(build)
+	if cond == nil {
+		return false, nil
+	}
+	switch cond.Status {
+	case corev1.ConditionTrue:
+		return true, nil
+	case corev1.ConditionFalse:
+		if cond.Message != "" {
+			return true, fmt.Errorf("build '%s' failed: %s : %s", build.Name, cond.Reason, cond.Message)
+		}
+		return true, fmt.Errorf("build '%s' failed: %s", build.Name, cond.Reason)
+	}
+	return false, nil
+}
@@ -0,0 +1,100 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package build
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+)
+
+func TestStepStatus(t *testing.T) {
+	testCases := []struct {
+		state    corev1.ContainerState
+		expected string
+	}{
+		{corev1.ContainerState{}, "Waiting"},
+		{corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "PodInitializing"}}, "Waiting (PodInitializing)"},
+		{corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}, "Running"},
+		{corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 0}}, "Completed"},
+		{corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 1, Reason: "Error"}}, "Failed (Error)"},
+	}
+	for _, tc := range testCases {
+		if status := StepStatus(tc.state); status != tc.expected {
+			t.Errorf("wrong status %s, expected %s", status, tc.expected)
+		}
+	}
+}
+
+func TestBuildUnstructuredRoundTrip(t *testing.T) {
+	build := NewGitBuild("default", "foo", "https://github.com/foo/bar", "v1", "kaniko",
+		map[string]string{"IMAGE": "gcr.io/foo/bar", "DOCKERFILE": "Dockerfile"})
+	obj, err := ToUnstructured(build)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if obj.GetKind() != "Build" || obj.GetAPIVersion() != "build.knative.dev/v1alpha1" {
+		t.Fatalf("wrong type %s %s", obj.GetAPIVersion(), obj.GetKind())
+	}
+	converted, err := FromUnstructured(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	args := converted.Spec.Template.Arguments
+	if len(args) != 2 || args[0].Name != "DOCKERFILE" || args[1].Name != "IMAGE" {
+		t.Fatalf("wrong arguments %v", args)
+	}
+}
+
+func TestWaitForBuildTimeout(t *testing.T) {
+	client := fake.NewSimpleDynamicClient(runtime.NewScheme())
+	err := WaitForBuild(client, "default", "foo", 10*time.Millisecond, ioutil.Discard)
+	if err == nil || !strings.Contains(err.Error(), "timeout") {
+		t.Fatalf("expected timeout, got %v", err)
+	}
+}
+
+func TestListFromUnstructured(t *testing.T) {
+	obj, err := ToUnstructured(NewGitBuild("default", "foo", "https://github.com/foo/bar", "", "kaniko", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	list := &unstructured.UnstructuredList{Items: []unstructured.Unstructured{*obj}}
+	builds, err := ListFromUnstructured(list)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(builds.Items) != 1 || builds.Items[0].Name != "foo" {
+		t.Fatalf("wrong builds %v", builds.Items)
+	}
+}
+
+func TestStepContainerStepName(t *testing.T) {
+	for container, expected := range map[string]string{
+		"build-step-build-and-push": "build-and-push",
+		"build-step-git-source-0":   "git-source-0",
+		"other":                     "other",
+	} {
+		if name := StepContainerStepName(container); name != expected {
+			t.Errorf("wrong step name %s, expected %s", name, expected)
+		}
+	}
+}
@@ -0,0 +1,291 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventing
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// CloudEvents are sent over HTTP either in binary mode, with the
+// attributes as 'ce-' headers and the data as body, or in structured mode,
+// with the whole event encoded as JSON body.
+
+// Version of the CloudEvents specification events are sent with
+const CloudEventsSpecVersion = "0.3"
+
+// Content type of events in structured mode
+const CloudEventsJSONContentType = "application/cloudevents+json"
+
+const cloudEventsHeaderPrefix = "Ce-"
+
+// CloudEvent holds the attributes and the data of an event. Attributes
+// other than the required and the content type are extensions.
+type CloudEvent struct {
+	SpecVersion     string
+	ID              string
+	Type            string
+	Source          string
+	Time            string
+	DataContentType string
+	Extensions      map[string]string
+	Data            []byte
+}
+
+// Create an event with a random ID
+func NewCloudEvent(eventType, source string) (*CloudEvent, error) {
+	id, err := NewEventID()
+	if err != nil {
+		return nil, err
+	}
+	return &CloudEvent{
+		SpecVersion: CloudEventsSpecVersion,
+		ID:          id,
+		Type:        eventType,
+		Source:      source,
+		Extensions:  map[string]string{},
+	}, nil
+}
+
+// Create a random UUID as event ID
+func NewEventID() (string, error) {
+	id := make([]byte, 16)
+	_, err := rand.Read(id)
+	if err != nil {
+		return "", err
+	}
+	id[6] = (id[6] & 0x0f) | 0x40
+	id[8] = (id[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", id[0:4], id[4:6], id[6:8], id[8:10], id[10:]), nil
+}
+
+// Check that the attributes required by the specification are set
+func (e *CloudEvent) Validate() error {
+	var missing []string
+	for _, attribute := range []struct{ name, value string }{
+		{"specversion", e.SpecVersion},
+		{"id", e.ID},
+		{"type", e.Type},
+		{"source", e.Source},
+	} {
+		if attribute.value == "" {
+			missing = append(missing, attribute.name)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("invalid event, missing attributes: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// Attributes of the event by name, including extensions
+func (e *CloudEvent) Attributes() map[string]string {
+	attributes := map[string]string{}
+	for name, value := range e.Extensions {
+		attributes[name] = value
+	}
+	for name, value := range map[string]string{
+		"specversion":     e.SpecVersion,
+		"id":              e.ID,
+		"type":            e.Type,
+		"source":          e.Source,
+		"time":            e.Time,
+		"datacontenttype": e.DataContentType,
+	} {
+		if value != "" {
+			attributes[name] = value
+		}
+	}
+	return attributes
+}
+
+// Encode the event in binary mode, setting the headers and returning the
+// body
+func (e *CloudEvent) EncodeBinary(header http.Header) []byte {
+	for name, value := range e.Attributes() {
+		if name == "datacontenttype" {
+			header.Set("Content-Type", value)
+			continue
+		}
+		header.Set(cloudEventsHeaderPrefix+name, value)
+	}
+	return e.Data
+}
+
+// Encode the event in structured mode, setting the content type and
+// returning the body
+func (e *CloudEvent) EncodeStructured(header http.Header) ([]byte, error) {
+	content := map[string]interface{}{}
+	for name, value := range e.Attributes() {
+		content[name] = value
+	}
+	switch {
+	case len(e.Data) == 0:
+	case isJSONContentType(e.DataContentType) && json.Valid(e.Data):
+		content["data"] = json.RawMessage(e.Data)
+	case utf8.Valid(e.Data):
+		content["data"] = string(e.Data)
+	default:
+		content["datacontentencoding"] = "base64"
+		content["data"] = base64.StdEncoding.EncodeToString(e.Data)
+	}
+	body, err := json.Marshal(content)
+	if err != nil {
+		return nil, err
+	}
+	header.Set("Content-Type", CloudEventsJSONContentType)
+	return body, nil
+}
+
+// Create a POST request sending the event to the given URL
+func NewCloudEventRequest(url string, event *CloudEvent, structured bool) (*http.Request, error) {
+	header := http.Header{}
+	var body []byte
+	if structured {
+		var err error
+		body, err = event.EncodeStructured(header)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		body = event.EncodeBinary(header)
+	}
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	return req, nil
+}
+
+// Decode an event received in binary or structured mode from the headers
+// and the body of a request or response. Nil is returned without error if
+// there is no event.
+func DecodeCloudEvent(header http.Header, body []byte) (*CloudEvent, error) {
+	mediaType, _, _ := mime.ParseMediaType(header.Get("Content-Type"))
+	if mediaType == CloudEventsJSONContentType {
+		return decodeStructured(body)
+	}
+
+	event := &CloudEvent{Extensions: map[string]string{}}
+	found := false
+	for name := range header {
+		if !strings.HasPrefix(name, cloudEventsHeaderPrefix) {
+			continue
+		}
+		found = true
+		event.setAttribute(strings.ToLower(strings.TrimPrefix(name, cloudEventsHeaderPrefix)), header.Get(name))
+	}
+	if !found {
+		return nil, nil
+	}
+	event.DataContentType = header.Get("Content-Type")
+	if len(body) > 0 {
+		event.Data = body
+	}
+	return event, nil
+}
+
+// Names of the extensions of the event, sorted
+func (e *CloudEvent) ExtensionNames() []string {
+	names := make([]string, 0, len(e.Extensions))
+	for name := range e.Extensions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// =======================================================================================
+
+func decodeStructured(body []byte) (*CloudEvent, error) {
+	content := map[string]json.RawMessage{}
+	err := json.Unmarshal(body, &content)
+	if err != nil {
+		return nil, fmt.Errorf("invalid structured event: %v", err)
+	}
+	event := &CloudEvent{Extensions: map[string]string{}}
+	for name, raw := range content {
+		if name == "data" || name == "data_base64" || name == "datacontentencoding" {
+			continue
+		}
+		var value string
+		if json.Unmarshal(raw, &value) != nil {
+			// Extensions may have other types than string
+			value = string(raw)
+		}
+		event.setAttribute(name, value)
+	}
+
+	var encoding string
+	json.Unmarshal(content["datacontentencoding"], &encoding)
+	data, ok := content["data"]
+	if base64Data, isBase64 := content["data_base64"]; isBase64 {
+		data, ok, encoding = base64Data, true, "base64"
+	}
+	if !ok {
+		return event, nil
+	}
+	var str string
+	if json.Unmarshal(data, &str) != nil {
+		// Data which isn't a string is JSON
+		event.Data = data
+		return event, nil
+	}
+	if encoding == "base64" {
+		event.Data, err = base64.StdEncoding.DecodeString(str)
+		if err != nil {
+			return nil, errors.New("invalid structured event: data is no valid base64")
+		}
+		return event, nil
+	}
+	event.Data = []byte(str)
+	return event, nil
+}
+
+func (e *CloudEvent) setAttribute(name, value string) {
+	switch name {
+	case "specversion":
+		e.SpecVersion = value
+	case "id":
+		e.ID = value
+	case "type":
+		e.Type = value
+	case "source":
+		e.Source = value
+	case "time":
+		e.Time = value
+	case "datacontenttype":
+		e.DataContentType = value
+	default:
+		e.Extensions[name] = value
+	}
+}
+
+func isJSONContentType(contentType string) bool {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	return mediaType == "application/json" || mediaType == "text/json" || strings.HasSuffix(mediaType, "+json")
+}
@@ -0,0 +1,147 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventing
+
+import (
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func newTestEvent(contentType, data string) *CloudEvent {
+	return &CloudEvent{
+		SpecVersion:     CloudEventsSpecVersion,
+		ID:              "1234",
+		Type:            "dev.knative.foo",
+		Source:          "/kn/test",
+		DataContentType: contentType,
+		Extensions:      map[string]string{"myext": "bar"},
+		Data:            []byte(data),
+	}
+}
+
+func TestNewCloudEvent(t *testing.T) {
+	event, err := NewCloudEvent("dev.knative.foo", "/kn/test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !regexp.MustCompile("^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$").MatchString(event.ID) {
+		t.Errorf("unexpected ID %s", event.ID)
+	}
+	if err = event.Validate(); err != nil {
+		t.Error(err)
+	}
+	err = (&CloudEvent{SpecVersion: CloudEventsSpecVersion, ID: "1"}).Validate()
+	if err == nil || err.Error() != "invalid event, missing attributes: type, source" {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestEncodeBinary(t *testing.T) {
+	header := http.Header{}
+	body := newTestEvent("text/plain", "hello").EncodeBinary(header)
+	if string(body) != "hello" {
+		t.Errorf("unexpected body %s", body)
+	}
+	expected := map[string]string{
+		"Ce-Specversion": "0.3",
+		"Ce-Id":          "1234",
+		"Ce-Type":        "dev.knative.foo",
+		"Ce-Source":      "/kn/test",
+		"Ce-Myext":       "bar",
+		"Content-Type":   "text/plain",
+	}
+	for name, value := range expected {
+		if header.Get(name) != value {
+			t.Errorf("header %s: got '%s', expected '%s'", name, header.Get(name), value)
+		}
+	}
+}
+
+func TestEncodeStructured(t *testing.T) {
+	for _, tc := range []struct {
+		event *CloudEvent
+		data  string
+	}{
+		{newTestEvent("application/json", `{"a":1}`), `"data":{"a":1}`},
+		{newTestEvent("text/plain", `hello`), `"data":"hello"`},
+		{newTestEvent("application/octet-stream", "\xff\xfe"), `"data":"//4=","datacontentencoding":"base64"`},
+	} {
+		header := http.Header{}
+		body, err := tc.event.EncodeStructured(header)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if header.Get("Content-Type") != CloudEventsJSONContentType {
+			t.Errorf("unexpected content type %s", header.Get("Content-Type"))
+		}
+		if !regexp.MustCompile(regexp.QuoteMeta(tc.data)).Match(body) {
+			t.Errorf("expected %s in %s", tc.data, body)
+		}
+	}
+}
+
+func TestDecodeRoundTrip(t *testing.T) {
+	for _, structured := range []bool{false, true} {
+		for _, event := range []*CloudEvent{
+			newTestEvent("application/json", `{"a":1}`),
+			newTestEvent("text/plain", "hello"),
+			newTestEvent("application/octet-stream", "\xff\xfe"),
+		} {
+			req, err := NewCloudEventRequest("http://example.com", event, structured)
+			if err != nil {
+				t.Fatal(err)
+			}
+			body, err := ioutil.ReadAll(req.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			decoded, err := DecodeCloudEvent(req.Header, body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(decoded, event) {
+				t.Errorf("structured %v: got %v, expected %v", structured, decoded, event)
+			}
+		}
+	}
+}
+
+func TestDecodeNoEvent(t *testing.T) {
+	header := http.Header{}
+	header.Set("Content-Type", "text/plain")
+	event, err := DecodeCloudEvent(header, []byte("hello"))
+	if err != nil || event != nil {
+		t.Errorf("expected no event, got %v, %v", event, err)
+	}
+}
+
+func TestDecodeStructuredBase64(t *testing.T) {
+	header := http.Header{}
+	header.Set("Content-Type", CloudEventsJSONContentType+"; charset=utf-8")
+	event, err := DecodeCloudEvent(header, []byte(`{"specversion":"1.0","id":"1","type":"t","source":"s","data_base64":"aGVsbG8=","count":3}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(event.Data) != "hello" || event.SpecVersion != "1.0" || event.Extensions["count"] != "3" {
+		t.Errorf("unexpected event %v", event)
+	}
+	_, err = DecodeCloudEvent(header, []byte(`{"specversion":`))
+	if err == nil {
+		t.Error("expected error for invalid event")
+	}
+}
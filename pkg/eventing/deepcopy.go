@@ -0,0 +1,183 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventing
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyObject implementations, making the eventing types runtime
+// objects for the printers
+
+func (in *Broker) DeepCopyObject() runtime.Object {
+	out := &Broker{TypeMeta: in.TypeMeta, Spec: in.Spec}
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Status.Status.DeepCopyInto(&out.Status.Status)
+	in.Status.Address.DeepCopyInto(&out.Status.Address)
+	return out
+}
+
+func (in *BrokerList) DeepCopyObject() runtime.Object {
+	out := &BrokerList{TypeMeta: in.TypeMeta}
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	for i := range in.Items {
+		out.Items = append(out.Items, *in.Items[i].DeepCopyObject().(*Broker))
+	}
+	return out
+}
+
+func (in *Trigger) DeepCopyObject() runtime.Object {
+	out := &Trigger{TypeMeta: in.TypeMeta, Spec: TriggerSpec{Broker: in.Spec.Broker}}
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Spec.Filter != nil {
+		out.Spec.Filter = &TriggerFilter{}
+		if in.Spec.Filter.SourceAndType != nil {
+			sourceAndType := *in.Spec.Filter.SourceAndType
+			out.Spec.Filter.SourceAndType = &sourceAndType
+		}
+	}
+	out.Spec.Subscriber = in.Spec.Subscriber.DeepCopy()
+	in.Status.Status.DeepCopyInto(&out.Status.Status)
+	out.Status.SubscriberURI = in.Status.SubscriberURI
+	return out
+}
+
+func (in *TriggerList) DeepCopyObject() runtime.Object {
+	out := &TriggerList{TypeMeta: in.TypeMeta}
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	for i := range in.Items {
+		out.Items = append(out.Items, *in.Items[i].DeepCopyObject().(*Trigger))
+	}
+	return out
+}
+
+func (in *SubscriberSpec) DeepCopy() *SubscriberSpec {
+	if in == nil {
+		return nil
+	}
+	out := &SubscriberSpec{Ref: in.Ref.DeepCopy()}
+	if in.URI != nil {
+		uri := *in.URI
+		out.URI = &uri
+	}
+	return out
+}
+
+func (in *SourceStatus) DeepCopyInto(out *SourceStatus) {
+	in.Status.DeepCopyInto(&out.Status)
+	out.SinkURI = in.SinkURI
+}
+
+func (in *CronJobSource) DeepCopyObject() runtime.Object {
+	out := &CronJobSource{TypeMeta: in.TypeMeta, Spec: in.Spec}
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec.Sink = in.Spec.Sink.DeepCopy()
+	in.Status.DeepCopyInto(&out.Status)
+	return out
+}
+
+func (in *CronJobSourceList) DeepCopyObject() runtime.Object {
+	out := &CronJobSourceList{TypeMeta: in.TypeMeta}
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	for i := range in.Items {
+		out.Items = append(out.Items, *in.Items[i].DeepCopyObject().(*CronJobSource))
+	}
+	return out
+}
+
+func (in *ApiServerSource) DeepCopyObject() runtime.Object {
+	out := &ApiServerSource{TypeMeta: in.TypeMeta, Spec: in.Spec}
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Spec.Resources != nil {
+		out.Spec.Resources = make([]ApiServerResource, len(in.Spec.Resources))
+		copy(out.Spec.Resources, in.Spec.Resources)
+	}
+	out.Spec.Sink = in.Spec.Sink.DeepCopy()
+	in.Status.DeepCopyInto(&out.Status)
+	return out
+}
+
+func (in *ApiServerSourceList) DeepCopyObject() runtime.Object {
+	out := &ApiServerSourceList{TypeMeta: in.TypeMeta}
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	for i := range in.Items {
+		out.Items = append(out.Items, *in.Items[i].DeepCopyObject().(*ApiServerSource))
+	}
+	return out
+}
+
+func (in *ContainerSource) DeepCopyObject() runtime.Object {
+	out := &ContainerSource{TypeMeta: in.TypeMeta, Spec: in.Spec}
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Spec.Args != nil {
+		out.Spec.Args = make([]string, len(in.Spec.Args))
+		copy(out.Spec.Args, in.Spec.Args)
+	}
+	if in.Spec.Env != nil {
+		out.Spec.Env = make([]corev1.EnvVar, len(in.Spec.Env))
+		for i := range in.Spec.Env {
+			in.Spec.Env[i].DeepCopyInto(&out.Spec.Env[i])
+		}
+	}
+	out.Spec.Sink = in.Spec.Sink.DeepCopy()
+	in.Status.DeepCopyInto(&out.Status)
+	return out
+}
+
+func (in *ContainerSourceList) DeepCopyObject() runtime.Object {
+	out := &ContainerSourceList{TypeMeta: in.TypeMeta}
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	for i := range in.Items {
+		out.Items = append(out.Items, *in.Items[i].DeepCopyObject().(*ContainerSource))
+	}
+	return out
+}
+
+func (in *Channel) DeepCopyObject() runtime.Object {
+	out := &Channel{TypeMeta: in.TypeMeta}
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Status.Status.DeepCopyInto(&out.Status.Status)
+	in.Status.Address.DeepCopyInto(&out.Status.Address)
+	return out
+}
+
+func (in *ChannelList) DeepCopyObject() runtime.Object {
+	out := &ChannelList{TypeMeta: in.TypeMeta}
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	for i := range in.Items {
+		out.Items = append(out.Items, *in.Items[i].DeepCopyObject().(*Channel))
+	}
+	return out
+}
+
+func (in *Subscription) DeepCopyObject() runtime.Object {
+	out := &Subscription{TypeMeta: in.TypeMeta}
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec.Channel = in.Spec.Channel
+	out.Spec.Subscriber = in.Spec.Subscriber.DeepCopy()
+	out.Spec.Reply = in.Spec.Reply.DeepCopy()
+	in.Status.Status.DeepCopyInto(&out.Status.Status)
+	return out
+}
+
+func (in *SubscriptionList) DeepCopyObject() runtime.Object {
+	out := &SubscriptionList{TypeMeta: in.TypeMeta}
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	for i := range in.Items {
+		out.Items = append(out.Items, *in.Items[i].DeepCopyObject().(*Subscription))
+	}
+	return out
+}
@@ -0,0 +1,128 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventing
+
+import (
+	"fmt"
+	"strings"
+
+	servingv1alpha1 "github.com/knative/serving/pkg/apis/serving/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Resources of Knative Eventing, which are accessed with the dynamic client
+var (
+	BrokerResource  = SchemeGroupVersion.WithResource("brokers")
+	TriggerResource = SchemeGroupVersion.WithResource("triggers")
+)
+
+// Convert an eventing object to its unstructured form as used by the
+// dynamic client
+func ToUnstructured(obj runtime.Object) (*unstructured.Unstructured, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	return &unstructured.Unstructured{Object: content}, nil
+}
+
+// Convert an unstructured object or list as returned by the dynamic client
+// into the given eventing object
+func FromUnstructured(obj runtime.Unstructured, into runtime.Object) error {
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), into)
+}
+
+// Set a field of an unstructured object to the unstructured form of the
+// given value. Fields of the object unknown to kn are preserved this way
+// when updating it.
+func SetNestedField(obj *unstructured.Unstructured, value interface{}, fields ...string) error {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(value)
+	if err != nil {
+		return err
+	}
+	return unstructured.SetNestedField(obj.Object, content, fields...)
+}
+
+// Copy the given spec fields of a typed object to the spec of its
+// unstructured form, keeping the other fields of the unstructured object.
+// Fields empty in the typed object are removed.
+func UpdateSpecFields(obj *unstructured.Unstructured, typed runtime.Object, fields ...string) error {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(typed)
+	if err != nil {
+		return err
+	}
+	for _, field := range fields {
+		value, ok, err := unstructured.NestedFieldNoCopy(content, "spec", field)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			unstructured.RemoveNestedField(obj.Object, "spec", field)
+			continue
+		}
+		err = unstructured.SetNestedField(obj.Object, value, "spec", field)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Parse a sink, which is either 'svc:NAME' for a Knative service,
+// 'broker:NAME' for a broker or an http(s) URI
+func ParseSink(sink string) (*SubscriberSpec, error) {
+	switch {
+	case strings.HasPrefix(sink, "svc:") && len(sink) > len("svc:"):
+		return &SubscriberSpec{Ref: &corev1.ObjectReference{
+			APIVersion: servingv1alpha1.SchemeGroupVersion.String(),
+			Kind:       "Service",
+			Name:       strings.TrimPrefix(sink, "svc:"),
+		}}, nil
+	case strings.HasPrefix(sink, "broker:") && len(sink) > len("broker:"):
+		return &SubscriberSpec{Ref: &corev1.ObjectReference{
+			APIVersion: SchemeGroupVersion.String(),
+			Kind:       "Broker",
+			Name:       strings.TrimPrefix(sink, "broker:"),
+		}}, nil
+	case strings.HasPrefix(sink, "http://") || strings.HasPrefix(sink, "https://"):
+		return &SubscriberSpec{URI: &sink}, nil
+	}
+	return nil, fmt.Errorf("invalid sink '%s', expected 'svc:NAME', 'broker:NAME' or an http(s) URI", sink)
+}
+
+// Format a sink the way it's given to ParseSink, falling back to
+// 'Kind:NAME' for other kinds of objects
+func FormatSink(sink *SubscriberSpec) string {
+	switch {
+	case sink == nil:
+		return ""
+	case sink.Ref != nil && sink.Ref.Kind == "Service" && sink.Ref.APIVersion == servingv1alpha1.SchemeGroupVersion.String():
+		return "svc:" + sink.Ref.Name
+	case sink.Ref != nil && sink.Ref.Kind == "Broker" && sink.Ref.APIVersion == SchemeGroupVersion.String():
+		return "broker:" + sink.Ref.Name
+	case sink.Ref != nil:
+		return sink.Ref.Kind + ":" + sink.Ref.Name
+	case sink.URI != nil:
+		return *sink.URI
+	}
+	return ""
+}
+
+// Get the URL events for the broker are sent to
+func BrokerURL(broker *Broker) string {
+	return AddressURL(broker.Status.Address)
+}
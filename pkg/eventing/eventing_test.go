@@ -0,0 +1,133 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventing
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/knative/pkg/apis"
+	duckv1beta1 "github.com/knative/pkg/apis/duck/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestParseSink(t *testing.T) {
+	for _, sink := range []string{"svc:foo", "broker:default", "http://example.com/events", "https://example.com"} {
+		spec, err := ParseSink(sink)
+		if err != nil {
+			t.Errorf("unexpected error for %s: %v", sink, err)
+			continue
+		}
+		if formatted := FormatSink(spec); formatted != sink {
+			t.Errorf("sink %s formatted as %s", sink, formatted)
+		}
+	}
+	for _, sink := range []string{"", "svc:", "foo", "ftp://example.com", "configuration:foo"} {
+		_, err := ParseSink(sink)
+		if err == nil {
+			t.Errorf("expected error for sink '%s'", sink)
+		}
+	}
+}
+
+func TestFormatSinkOtherKind(t *testing.T) {
+	sink := &SubscriberSpec{Ref: &corev1.ObjectReference{Kind: "Channel", Name: "foo"}}
+	if formatted := FormatSink(sink); formatted != "Channel:foo" {
+		t.Errorf("unexpected sink %s", formatted)
+	}
+	if formatted := FormatSink(nil); formatted != "" {
+		t.Errorf("unexpected sink %s", formatted)
+	}
+}
+
+func TestUnstructuredRoundTrip(t *testing.T) {
+	uri := "http://example.com"
+	trigger := &Trigger{
+		TypeMeta:   metav1.TypeMeta{APIVersion: SchemeGroupVersion.String(), Kind: "Trigger"},
+		ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+		Spec: TriggerSpec{
+			Broker:     "default",
+			Filter:     &TriggerFilter{SourceAndType: &TriggerFilterSourceAndType{Type: "dev.knative.foo", Source: TriggerAnyFilter}},
+			Subscriber: &SubscriberSpec{URI: &uri},
+		},
+		Status: TriggerStatus{
+			Status: duckv1beta1.Status{Conditions: duckv1beta1.Conditions{
+				{Type: apis.ConditionReady, Status: corev1.ConditionTrue},
+			}},
+			SubscriberURI: uri,
+		},
+	}
+	obj, err := ToUnstructured(trigger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	broker, _, _ := unstructured.NestedString(obj.Object, "spec", "broker")
+	if broker != "default" {
+		t.Errorf("unexpected broker %s", broker)
+	}
+	converted := &Trigger{}
+	err = FromUnstructured(obj, converted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(converted, trigger) {
+		t.Errorf("got %v, expected %v", converted, trigger)
+	}
+	if copied := trigger.DeepCopyObject(); !reflect.DeepEqual(copied, trigger) {
+		t.Errorf("got copy %v, expected %v", copied, trigger)
+	}
+}
+
+func TestListFromUnstructured(t *testing.T) {
+	broker := &Broker{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+	broker.Status.Address.Hostname = "default-broker.default.svc.cluster.local"
+	obj, err := ToUnstructured(broker)
+	if err != nil {
+		t.Fatal(err)
+	}
+	list := &unstructured.UnstructuredList{Items: []unstructured.Unstructured{*obj}}
+	brokers := &BrokerList{}
+	err = FromUnstructured(list, brokers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(brokers.Items) != 1 || brokers.Items[0].Name != "default" {
+		t.Fatalf("unexpected brokers %v", brokers.Items)
+	}
+	if url := BrokerURL(&brokers.Items[0]); url != "http://default-broker.default.svc.cluster.local" {
+		t.Errorf("unexpected URL %s", url)
+	}
+}
+
+func TestSetNestedField(t *testing.T) {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{"broker": "default", "unknown": "keep"},
+	}}
+	filter := &TriggerFilter{SourceAndType: &TriggerFilterSourceAndType{Type: "dev.knative.foo", Source: TriggerAnyFilter}}
+	err := SetNestedField(obj, filter, "spec", "filter")
+	if err != nil {
+		t.Fatal(err)
+	}
+	eventType, _, _ := unstructured.NestedString(obj.Object, "spec", "filter", "sourceAndType", "type")
+	if eventType != "dev.knative.foo" {
+		t.Errorf("unexpected type %s", eventType)
+	}
+	unknown, _, _ := unstructured.NestedString(obj.Object, "spec", "unknown")
+	if unknown != "keep" {
+		t.Errorf("unknown field not preserved")
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventing
+
+import (
+	"fmt"
+	"strings"
+
+	duckv1alpha1 "github.com/knative/pkg/apis/duck/v1alpha1"
+	duckv1beta1 "github.com/knative/pkg/apis/duck/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Like the eventing types, the types below mirror the fields of channels
+// and subscriptions kn makes use of.
+
+// Group and version of the channels
+var MessagingSchemeGroupVersion = schema.GroupVersion{Group: "messaging.knative.dev", Version: "v1alpha1"}
+
+// Kind of channels created if no other kind is given
+const DefaultChannelKind = "InMemoryChannel"
+
+// Resource of subscriptions, which are accessed with the dynamic client
+var SubscriptionResource = SchemeGroupVersion.WithResource("subscriptions")
+
+// Get the resource of channels of the given kind, e.g. 'inmemorychannels'
+// for 'InMemoryChannel'
+func ChannelResource(kind string) schema.GroupVersionResource {
+	return MessagingSchemeGroupVersion.WithResource(strings.ToLower(kind) + "s")
+}
+
+// Channel of any kind. The spec of channels depends on their kind and
+// isn't mirrored.
+type Channel struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Status ChannelStatus `json:"status,omitempty"`
+}
+
+type ChannelStatus struct {
+	duckv1beta1.Status `json:",inline"`
+
+	// Address events are sent to
+	Address duckv1alpha1.Addressable `json:"address,omitempty"`
+}
+
+type ChannelList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []Channel `json:"items"`
+}
+
+// Subscription delivers the events of a channel to a subscriber, sending
+// its replies to the reply destination
+type Subscription struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SubscriptionSpec   `json:"spec,omitempty"`
+	Status SubscriptionStatus `json:"status,omitempty"`
+}
+
+type SubscriptionSpec struct {
+	Channel    corev1.ObjectReference `json:"channel"`
+	Subscriber *SubscriberSpec        `json:"subscriber,omitempty"`
+	Reply      *SubscriberSpec        `json:"reply,omitempty"`
+}
+
+type SubscriptionStatus struct {
+	duckv1beta1.Status `json:",inline"`
+}
+
+type SubscriptionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []Subscription `json:"items"`
+}
+
+// Parse a channel reference, which is either 'NAME' for a channel of the
+// default kind or 'KIND:NAME'
+func ParseChannel(channel string) (*corev1.ObjectReference, error) {
+	kind, name := DefaultChannelKind, channel
+	if parts := strings.SplitN(channel, ":", 2); len(parts) == 2 {
+		kind, name = parts[0], parts[1]
+	}
+	if kind == "" || name == "" {
+		return nil, fmt.Errorf("invalid channel '%s', expected 'NAME' or 'KIND:NAME'", channel)
+	}
+	return &corev1.ObjectReference{
+		APIVersion: MessagingSchemeGroupVersion.String(),
+		Kind:       kind,
+		Name:       name,
+	}, nil
+}
+
+// Get the URL of an addressable object
+func AddressURL(address duckv1alpha1.Addressable) string {
+	if address.URL != nil {
+		return address.URL.String()
+	}
+	if address.Hostname != "" {
+		return "http://" + address.Hostname
+	}
+	return ""
+}
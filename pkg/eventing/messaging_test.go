@@ -0,0 +1,73 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventing
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestChannelResource(t *testing.T) {
+	resource := ChannelResource("KafkaChannel")
+	if resource.Group != "messaging.knative.dev" || resource.Resource != "kafkachannels" {
+		t.Errorf("unexpected resource %v", resource)
+	}
+}
+
+func TestParseChannel(t *testing.T) {
+	ref, err := ParseChannel("foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := &corev1.ObjectReference{APIVersion: "messaging.knative.dev/v1alpha1", Kind: "InMemoryChannel", Name: "foo"}
+	if !reflect.DeepEqual(ref, expected) {
+		t.Errorf("got %v, expected %v", ref, expected)
+	}
+	ref, err = ParseChannel("KafkaChannel:bar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ref.Kind != "KafkaChannel" || ref.Name != "bar" {
+		t.Errorf("unexpected reference %v", ref)
+	}
+	for _, channel := range []string{"", ":bar", "KafkaChannel:"} {
+		_, err = ParseChannel(channel)
+		if err == nil {
+			t.Errorf("expected error for channel '%s'", channel)
+		}
+	}
+}
+
+func TestSubscriptionDeepCopy(t *testing.T) {
+	uri := "http://example.com"
+	subscription := &Subscription{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+		Spec: SubscriptionSpec{
+			Channel:    corev1.ObjectReference{Kind: DefaultChannelKind, Name: "foo"},
+			Subscriber: &SubscriberSpec{Ref: &corev1.ObjectReference{Kind: "Service", Name: "foo"}},
+			Reply:      &SubscriberSpec{URI: &uri},
+		},
+	}
+	copied := subscription.DeepCopyObject().(*Subscription)
+	if !reflect.DeepEqual(copied, subscription) {
+		t.Errorf("got copy %v, expected %v", copied, subscription)
+	}
+	if copied.Spec.Reply.URI == subscription.Spec.Reply.URI {
+		t.Error("reply not deep copied")
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventing
+
+import (
+	"fmt"
+
+	duckv1beta1 "github.com/knative/pkg/apis/duck/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Like the eventing types, the types below mirror the fields of the event
+// sources of sources.eventing.knative.dev/v1alpha1 kn makes use of.
+
+// Group and version of the event sources
+var SourcesSchemeGroupVersion = schema.GroupVersion{Group: "sources.eventing.knative.dev", Version: "v1alpha1"}
+
+// Resources of the event sources, which are accessed with the dynamic client
+var (
+	CronJobSourceResource   = SourcesSchemeGroupVersion.WithResource("cronjobsources")
+	ApiServerSourceResource = SourcesSchemeGroupVersion.WithResource("apiserversources")
+	ContainerSourceResource = SourcesSchemeGroupVersion.WithResource("containersources")
+)
+
+// Modes of an API server source, sending either references to the changed
+// objects or the objects themselves
+const (
+	ApiServerSourceModeRef      = "Ref"
+	ApiServerSourceModeResource = "Resource"
+)
+
+// Status shared by all event sources
+type SourceStatus struct {
+	duckv1beta1.Status `json:",inline"`
+
+	// URI events are sent to, resolved from the sink
+	SinkURI string `json:"sinkUri,omitempty"`
+}
+
+// CronJobSource sends an event with fixed data on a cron schedule
+type CronJobSource struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CronJobSourceSpec `json:"spec,omitempty"`
+	Status SourceStatus      `json:"status,omitempty"`
+}
+
+type CronJobSourceSpec struct {
+	Schedule           string                  `json:"schedule"`
+	Data               string                  `json:"data,omitempty"`
+	Sink               *corev1.ObjectReference `json:"sink,omitempty"`
+	ServiceAccountName string                  `json:"serviceAccountName,omitempty"`
+}
+
+type CronJobSourceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []CronJobSource `json:"items"`
+}
+
+// ApiServerSource sends an event for each change of the watched
+// Kubernetes resources
+type ApiServerSource struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ApiServerSourceSpec `json:"spec,omitempty"`
+	Status SourceStatus        `json:"status,omitempty"`
+}
+
+type ApiServerSourceSpec struct {
+	Resources          []ApiServerResource     `json:"resources,omitempty"`
+	ServiceAccountName string                  `json:"serviceAccountName,omitempty"`
+	Sink               *corev1.ObjectReference `json:"sink,omitempty"`
+	Mode               string                  `json:"mode,omitempty"`
+}
+
+// Kubernetes resource watched by an API server source. If Controller is
+// set, events are sent for the controller of the changed object instead.
+type ApiServerResource struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Controller bool   `json:"controller,omitempty"`
+}
+
+type ApiServerSourceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []ApiServerSource `json:"items"`
+}
+
+// ContainerSource runs a container image producing events
+type ContainerSource struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ContainerSourceSpec `json:"spec,omitempty"`
+	Status SourceStatus        `json:"status,omitempty"`
+}
+
+type ContainerSourceSpec struct {
+	Image              string                  `json:"image,omitempty"`
+	Args               []string                `json:"args,omitempty"`
+	Env                []corev1.EnvVar         `json:"env,omitempty"`
+	ServiceAccountName string                  `json:"serviceAccountName,omitempty"`
+	Sink               *corev1.ObjectReference `json:"sink,omitempty"`
+}
+
+type ContainerSourceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []ContainerSource `json:"items"`
+}
+
+// Get the object reference of a sink, as required by event sources. Sink
+// URIs can't be referenced.
+func SinkReference(sink *SubscriberSpec) (*corev1.ObjectReference, error) {
+	if sink.Ref == nil {
+		return nil, fmt.Errorf("sink '%s' is no object reference, expected 'svc:NAME' or 'broker:NAME'", FormatSink(sink))
+	}
+	return sink.Ref, nil
+}
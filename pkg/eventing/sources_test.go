@@ -0,0 +1,99 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventing
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestSinkReference(t *testing.T) {
+	sink, err := ParseSink("broker:default")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ref, err := SinkReference(sink)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ref.APIVersion != "eventing.knative.dev/v1alpha1" || ref.Kind != "Broker" || ref.Name != "default" {
+		t.Errorf("unexpected reference %v", ref)
+	}
+
+	sink, err = ParseSink("http://example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = SinkReference(sink)
+	if err == nil {
+		t.Error("expected error for sink URI")
+	}
+}
+
+func TestSourcesDeepCopy(t *testing.T) {
+	sink := &corev1.ObjectReference{Kind: "Service", Name: "foo"}
+	for _, source := range []runtime.Object{
+		&CronJobSource{
+			ObjectMeta: metav1.ObjectMeta{Name: "cron"},
+			Spec:       CronJobSourceSpec{Schedule: "* * * * *", Sink: sink},
+		},
+		&ApiServerSource{
+			ObjectMeta: metav1.ObjectMeta{Name: "apiserver"},
+			Spec:       ApiServerSourceSpec{Resources: []ApiServerResource{{APIVersion: "v1", Kind: "Event"}}, Sink: sink},
+		},
+		&ContainerSource{
+			ObjectMeta: metav1.ObjectMeta{Name: "container"},
+			Spec: ContainerSourceSpec{
+				Image: "gcr.io/foo/bar",
+				Args:  []string{"--period=1"},
+				Env:   []corev1.EnvVar{{Name: "A", Value: "1"}},
+				Sink:  sink,
+			},
+		},
+	} {
+		if copied := source.DeepCopyObject(); !reflect.DeepEqual(copied, source) {
+			t.Errorf("got copy %v, expected %v", copied, source)
+		}
+	}
+}
+
+func TestUpdateSpecFields(t *testing.T) {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{
+			"schedule":           "* * * * *",
+			"data":               "foo",
+			"serviceAccountName": "sa",
+			"unknown":            "keep",
+		},
+	}}
+	source := &CronJobSource{Spec: CronJobSourceSpec{Schedule: "*/2 * * * *", Data: "foo"}}
+	err := UpdateSpecFields(obj, source, "schedule", "serviceAccountName")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]interface{}{
+		"schedule": "*/2 * * * *",
+		"data":     "foo",
+		"unknown":  "keep",
+	}
+	if !reflect.DeepEqual(obj.Object["spec"], expected) {
+		t.Errorf("got spec %v, expected %v", obj.Object["spec"], expected)
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventing
+
+import (
+	duckv1alpha1 "github.com/knative/pkg/apis/duck/v1alpha1"
+	duckv1beta1 "github.com/knative/pkg/apis/duck/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// The resources of Knative Eventing are accessed with the dynamic client.
+// As Knative Eventing isn't vendored, the types below mirror the fields of
+// eventing.knative.dev/v1alpha1 kn makes use of.
+
+// Group and version of the eventing resources
+var SchemeGroupVersion = schema.GroupVersion{Group: "eventing.knative.dev", Version: "v1alpha1"}
+
+// Value of a trigger filter attribute matching all events
+const TriggerAnyFilter = "Any"
+
+// Broker receives events and delivers them to the subscribers of its
+// triggers
+type Broker struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BrokerSpec   `json:"spec,omitempty"`
+	Status BrokerStatus `json:"status,omitempty"`
+}
+
+type BrokerSpec struct {
+}
+
+type BrokerStatus struct {
+	duckv1beta1.Status `json:",inline"`
+
+	// Address events are sent to
+	Address duckv1alpha1.Addressable `json:"address,omitempty"`
+}
+
+type BrokerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []Broker `json:"items"`
+}
+
+// Trigger subscribes to the events of a broker matching its filter
+type Trigger struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TriggerSpec   `json:"spec,omitempty"`
+	Status TriggerStatus `json:"status,omitempty"`
+}
+
+type TriggerSpec struct {
+	Broker     string          `json:"broker,omitempty"`
+	Filter     *TriggerFilter  `json:"filter,omitempty"`
+	Subscriber *SubscriberSpec `json:"subscriber,omitempty"`
+}
+
+type TriggerFilter struct {
+	SourceAndType *TriggerFilterSourceAndType `json:"sourceAndType,omitempty"`
+}
+
+// Exact match filter on the type and the source of events, with
+// TriggerAnyFilter matching all values
+type TriggerFilterSourceAndType struct {
+	Type   string `json:"type,omitempty"`
+	Source string `json:"source,omitempty"`
+}
+
+// Destination of events, either an addressable object or a URI
+type SubscriberSpec struct {
+	Ref *corev1.ObjectReference `json:"ref,omitempty"`
+	URI *string                 `json:"uri,omitempty"`
+}
+
+type TriggerStatus struct {
+	duckv1beta1.Status `json:",inline"`
+
+	SubscriberURI string `json:"subscriberURI,omitempty"`
+}
+
+type TriggerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []Trigger `json:"items"`
+}
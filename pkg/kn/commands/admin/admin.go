@@ -0,0 +1,44 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/spf13/cobra"
+)
+
+func NewAdminCommand(p *commands.KnParams) *cobra.Command {
+	adminCmd := &cobra.Command{
+		Use:   "admin",
+		Short: "Administration command group",
+	}
+	adminCmd.AddCommand(NewAdminConfigCommand(p))
+	return adminCmd
+}
+
+func NewAdminConfigCommand(p *commands.KnParams) *cobra.Command {
+	adminConfigCmd := &cobra.Command{
+		Use:   "config",
+		Short: "Inspect and change the serving configuration of the cluster",
+		Long: `Inspect and change the serving configuration of the cluster.
+
+The configuration is split into the sections autoscaler, network, gc and
+deployment, stored in the ConfigMaps 'config-SECTION' of namespace
+'knative-serving'.`,
+	}
+	adminConfigCmd.AddCommand(NewAdminConfigGetCommand(p))
+	adminConfigCmd.AddCommand(NewAdminConfigSetCommand(p))
+	return adminConfigCmd
+}
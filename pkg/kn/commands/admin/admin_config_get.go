@@ -0,0 +1,86 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/knative/client/pkg/kn/commands"
+	hprinters "github.com/knative/client/pkg/printers"
+	servinglib "github.com/knative/client/pkg/serving"
+	"github.com/spf13/cobra"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NewAdminConfigGetCommand represents 'kn admin config get' command
+func NewAdminConfigGetCommand(p *commands.KnParams) *cobra.Command {
+	adminConfigGetCommand := &cobra.Command{
+		Use:   "get SECTION [KEY]",
+		Short: "Show the settings of a configuration section.",
+		Example: `
+  # Show the settings of the autoscaler
+  kn admin config get autoscaler
+
+  # Show the stable window of the autoscaler
+  kn admin config get autoscaler stable-window`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) < 1 || len(args) > 2 {
+				return errors.New("requires the section and optionally a key.")
+			}
+			section, err := servinglib.GetConfigSection(args[0])
+			if err != nil {
+				return err
+			}
+			client, err := p.CoreFactory()
+			if err != nil {
+				return err
+			}
+			configMap, err := client.ConfigMaps(servinglib.ServingNamespace).Get(section.ConfigMap, v1.GetOptions{})
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 2 {
+				value, ok := configMap.Data[args[1]]
+				if !ok {
+					return fmt.Errorf("key '%s' is not set in section '%s'.", args[1], section.Name)
+				}
+				fmt.Fprintln(cmd.OutOrStdout(), value)
+				return nil
+			}
+
+			keys := make([]string, 0, len(configMap.Data))
+			for key := range configMap.Data {
+				if key != servinglib.ConfigExampleKey {
+					keys = append(keys, key)
+				}
+			}
+			if len(keys) == 0 {
+				fmt.Fprintf(cmd.OutOrStdout(), "No settings in section '%s', serving uses its defaults.\n", section.Name)
+				return nil
+			}
+			sort.Strings(keys)
+			w := hprinters.GetNewTabWriter(cmd.OutOrStdout())
+			fmt.Fprintln(w, "KEY\tVALUE")
+			for _, key := range keys {
+				fmt.Fprintf(w, "%s\t%s\n", key, configMap.Data[key])
+			}
+			return w.Flush()
+		},
+	}
+	return adminConfigGetCommand
+}
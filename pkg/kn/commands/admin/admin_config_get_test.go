@@ -0,0 +1,112 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/knative/client/pkg/kn/commands"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	client_testing "k8s.io/client-go/testing"
+)
+
+// fakeAdminConfig runs an admin config command against a fake ConfigMap
+// with the given data. The name of the ConfigMap read and the data of a
+// merge patch are returned.
+func fakeAdminConfig(args []string, data map[string]string) (
+	configMapName string,
+	patch map[string]*string,
+	output string,
+	err error) {
+	knParams := &commands.KnParams{}
+	cmd, _, fakeCore, buf := commands.CreateTestKnCommandWithCore(NewAdminCommand(knParams), knParams)
+	fakeCore.AddReactor("get", "configmaps",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			configMapName = a.(client_testing.GetAction).GetName()
+			return true, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: configMapName, Namespace: a.GetNamespace()},
+				Data:       data,
+			}, nil
+		})
+	fakeCore.AddReactor("patch", "configmaps",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			var body struct {
+				Data map[string]*string `json:"data"`
+			}
+			err := json.Unmarshal(a.(client_testing.PatchAction).GetPatch(), &body)
+			if err != nil {
+				return true, nil, err
+			}
+			patch = body.Data
+			return true, &corev1.ConfigMap{}, nil
+		})
+	cmd.SetArgs(args)
+	err = cmd.Execute()
+	output = buf.String()
+	return
+}
+
+func TestAdminConfigGet(t *testing.T) {
+	name, _, output, err := fakeAdminConfig([]string{"admin", "config", "get", "autoscaler"},
+		map[string]string{"stable-window": "60s", "max-scale-up-rate": "10", "_example": "docs"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "config-autoscaler" {
+		t.Errorf("wrong ConfigMap %s", name)
+	}
+	lines := strings.Split(output, "\n")
+	testContains(t, lines[0], []string{"KEY", "VALUE"}, "column header")
+	testContains(t, lines[1], []string{"max-scale-up-rate", "10"}, "value")
+	testContains(t, lines[2], []string{"stable-window", "60s"}, "value")
+	if strings.Contains(output, "_example") {
+		t.Errorf("example shown: %s", output)
+	}
+}
+
+func TestAdminConfigGetKey(t *testing.T) {
+	_, _, output, err := fakeAdminConfig([]string{"admin", "config", "get", "config-network", "httpProtocol"},
+		map[string]string{"httpProtocol": "Redirected"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if output != "Redirected\n" {
+		t.Errorf("unexpected output: %s", output)
+	}
+
+	_, _, _, err = fakeAdminConfig([]string{"admin", "config", "get", "network", "autoTLS"}, nil)
+	if err == nil || err.Error() != "key 'autoTLS' is not set in section 'network'." {
+		t.Errorf("expected error for unset key, got %v", err)
+	}
+}
+
+func TestAdminConfigGetUnknownSection(t *testing.T) {
+	_, _, _, err := fakeAdminConfig([]string{"admin", "config", "get", "observability"}, nil)
+	if err == nil || !strings.HasPrefix(err.Error(), "unknown configuration section 'observability'") {
+		t.Errorf("expected error for unknown section, got %v", err)
+	}
+}
+
+func testContains(t *testing.T, output string, sub []string, element string) {
+	for _, each := range sub {
+		if !strings.Contains(output, each) {
+			t.Errorf("Missing %s: %s", element, each)
+		}
+	}
+}
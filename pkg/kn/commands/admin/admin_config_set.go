@@ -0,0 +1,177 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/knative/client/pkg/kn/commands"
+	servinglib "github.com/knative/client/pkg/serving"
+	"github.com/spf13/cobra"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// NewAdminConfigSetCommand represents 'kn admin config set' command
+func NewAdminConfigSetCommand(p *commands.KnParams) *cobra.Command {
+	var dryRun bool
+
+	adminConfigSetCommand := &cobra.Command{
+		Use:   "set SECTION KEY=VALUE...",
+		Short: "Change the settings of a configuration section.",
+		Long: `Change the settings of a configuration section.
+
+The new settings are validated the way serving parses them and the changes
+are shown before they are applied. A setting given as 'KEY-' is removed, so
+that serving falls back to its default.
+
+Serving's parser of the autoscaler section isn't part of kn. For this section,
+only the types of the values and the minimum of 30s for the
+scale-to-zero-grace-period are checked; other constraints, e.g. on the
+ranges of percentages, are only enforced by serving itself.`,
+		Example: `
+  # Shorten the stable window of the autoscaler and show the changes
+  kn admin config set autoscaler stable-window=30s
+
+  # Only show what redirecting HTTP to HTTPS would change
+  kn admin config set network httpProtocol=Redirected --dry-run
+
+  # Return to the default grace period for scaling to zero
+  kn admin config set autoscaler scale-to-zero-grace-period-`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) < 2 {
+				return errors.New("requires the section and at least one KEY=VALUE setting.")
+			}
+			section, err := servinglib.GetConfigSection(args[0])
+			if err != nil {
+				return err
+			}
+			changes, err := parseSettings(args[1:])
+			if err != nil {
+				return err
+			}
+
+			client, err := p.CoreFactory()
+			if err != nil {
+				return err
+			}
+			configMap, err := client.ConfigMaps(servinglib.ServingNamespace).Get(section.ConfigMap, v1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			keys := make([]string, 0, len(changes))
+			for key := range changes {
+				keys = append(keys, key)
+			}
+			err = section.CheckKeys(keys, configMap.Data)
+			if err != nil {
+				return err
+			}
+			updated := map[string]string{}
+			for key, value := range configMap.Data {
+				updated[key] = value
+			}
+			for key, value := range changes {
+				if value == nil {
+					delete(updated, key)
+				} else {
+					updated[key] = *value
+				}
+			}
+			err = section.Validate(updated)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			if !printDiff(out, configMap.Data, updated) {
+				fmt.Fprintf(out, "No changes to section '%s'.\n", section.Name)
+				return nil
+			}
+			if dryRun {
+				return nil
+			}
+			patch, err := json.Marshal(map[string]interface{}{"data": changes})
+			if err != nil {
+				return err
+			}
+			_, err = client.ConfigMaps(servinglib.ServingNamespace).Patch(section.ConfigMap, types.MergePatchType, patch)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(out, "Section '%s' successfully updated.\n", section.Name)
+			return nil
+		},
+	}
+	adminConfigSetCommand.Flags().BoolVar(&dryRun, "dry-run", false, "Only show the changes, don't apply them.")
+	return adminConfigSetCommand
+}
+
+// parseSettings parses 'KEY=VALUE' settings and 'KEY-' removals, the latter
+// resulting in a nil value
+func parseSettings(settings []string) (map[string]*string, error) {
+	changes := map[string]*string{}
+	for _, setting := range settings {
+		if strings.HasSuffix(setting, "-") && !strings.Contains(setting, "=") {
+			changes[strings.TrimSuffix(setting, "-")] = nil
+			continue
+		}
+		parts := strings.SplitN(setting, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid setting '%s', expected KEY=VALUE or KEY-", setting)
+		}
+		changes[parts[0]] = &parts[1]
+	}
+	return changes, nil
+}
+
+// printDiff prints the settings which differ, returning whether there are
+// any differences
+func printDiff(out io.Writer, old, updated map[string]string) bool {
+	keys := map[string]bool{}
+	for key := range old {
+		keys[key] = true
+	}
+	for key := range updated {
+		keys[key] = true
+	}
+	sorted := make([]string, 0, len(keys))
+	for key := range keys {
+		sorted = append(sorted, key)
+	}
+	sort.Strings(sorted)
+
+	changed := false
+	for _, key := range sorted {
+		oldValue, inOld := old[key]
+		newValue, inUpdated := updated[key]
+		if inOld == inUpdated && oldValue == newValue {
+			continue
+		}
+		changed = true
+		if inOld {
+			fmt.Fprintf(out, "- %s: %s\n", key, oldValue)
+		}
+		if inUpdated {
+			fmt.Fprintf(out, "+ %s: %s\n", key, newValue)
+		}
+	}
+	return changed
+}
@@ -0,0 +1,101 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAdminConfigSet(t *testing.T) {
+	_, patch, output, err := fakeAdminConfig(
+		[]string{"admin", "config", "set", "autoscaler", "stable-window=30s", "panic-window-percentage=20", "tick-interval-"},
+		map[string]string{"stable-window": "60s", "tick-interval": "2s", "max-scale-up-rate": "10"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if output != "+ panic-window-percentage: 20\n"+
+		"- stable-window: 60s\n"+
+		"+ stable-window: 30s\n"+
+		"- tick-interval: 2s\n"+
+		"Section 'autoscaler' successfully updated.\n" {
+		t.Errorf("unexpected output:\n%s", output)
+	}
+	if len(patch) != 3 || *patch["stable-window"] != "30s" || *patch["panic-window-percentage"] != "20" {
+		t.Errorf("wrong patch %v", patch)
+	}
+	if value, ok := patch["tick-interval"]; !ok || value != nil {
+		t.Errorf("tick-interval not removed: %v", patch)
+	}
+}
+
+func TestAdminConfigSetDryRun(t *testing.T) {
+	_, patch, output, err := fakeAdminConfig([]string{"admin", "config", "set", "gc", "stale-revision-timeout=10h", "--dry-run"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if output != "+ stale-revision-timeout: 10h\n" {
+		t.Errorf("unexpected output: %s", output)
+	}
+	if patch != nil {
+		t.Errorf("ConfigMap patched on dry run")
+	}
+}
+
+func TestAdminConfigSetNoChange(t *testing.T) {
+	_, patch, output, err := fakeAdminConfig([]string{"admin", "config", "set", "network", "autoTLS=Enabled"},
+		map[string]string{"autoTLS": "Enabled"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if output != "No changes to section 'network'.\n" || patch != nil {
+		t.Errorf("unexpected output %s or patch %v", output, patch)
+	}
+}
+
+func TestAdminConfigSetRemoveUnknownKey(t *testing.T) {
+	_, patch, output, err := fakeAdminConfig([]string{"admin", "config", "set", "autoscaler", "stable-windows-"},
+		map[string]string{"stable-windows": "60s"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(output, "- stable-windows: 60s\n") {
+		t.Errorf("unexpected output: %s", output)
+	}
+	if value, ok := patch["stable-windows"]; !ok || value != nil {
+		t.Errorf("stable-windows not removed: %v", patch)
+	}
+}
+
+func TestAdminConfigSetInvalid(t *testing.T) {
+	for _, tc := range []struct {
+		args []string
+		err  string
+	}{
+		{[]string{"admin", "config", "set", "autoscaler"}, "requires the section and at least one KEY=VALUE setting."},
+		{[]string{"admin", "config", "set", "autoscaler", "stable-window"}, "invalid setting 'stable-window'"},
+		{[]string{"admin", "config", "set", "autoscaler", "stable-window=1 minute"}, "invalid configuration of section 'autoscaler'"},
+		{[]string{"admin", "config", "set", "autoscaler", "stable-windows=60s"}, "unknown keys stable-windows"},
+		{[]string{"admin", "config", "set", "deployment", "queueSidecarImage-"}, "Queue sidecar image is missing"},
+	} {
+		_, patch, output, err := fakeAdminConfig(tc.args, map[string]string{"queueSidecarImage": "gcr.io/queue"})
+		if err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("expected error containing '%s' for %v, got %v", tc.err, tc.args, err)
+		}
+		if patch != nil || output != "" {
+			t.Errorf("unexpected output %s or patch %v for %v", output, patch, tc.args)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package broker
+
+import (
+	"testing"
+
+	"github.com/knative/client/pkg/eventing"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestBrokerCreate(t *testing.T) {
+	fakeDynamic, output, err := fakeBroker([]string{"broker", "create", "default", "-n", "ns1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if output[0] != "Broker 'default' successfully created in namespace 'ns1'." {
+		t.Errorf("unexpected output: %s", output[0])
+	}
+	obj, err := fakeDynamic.Resource(eventing.BrokerResource).Namespace("ns1").Get("default", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if obj.GetKind() != "Broker" {
+		t.Errorf("unexpected kind %s", obj.GetKind())
+	}
+}
+
+func TestBrokerCreateNoName(t *testing.T) {
+	_, _, err := fakeBroker([]string{"broker", "create"})
+	if err == nil || err.Error() != "requires the broker name." {
+		t.Errorf("expected error for missing name, got %v", err)
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package broker
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/knative/client/pkg/eventing"
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func NewBrokerDeleteCommand(p *commands.KnParams) *cobra.Command {
+	brokerDeleteCommand := &cobra.Command{
+		Use:   "delete NAME",
+		Short: "Delete a broker.",
+		Example: `
+  # Delete the broker 'default' in the default namespace
+  kn broker delete default`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("requires the broker name.")
+			}
+			namespace, err := commands.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.DynamicFactory()
+			if err != nil {
+				return err
+			}
+			err = client.Resource(eventing.BrokerResource).Namespace(namespace).Delete(args[0], &metav1.DeleteOptions{})
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Broker '%s' successfully deleted in namespace '%s'.\n", args[0], namespace)
+			return nil
+		},
+	}
+	commands.AddNamespaceFlags(brokerDeleteCommand.Flags(), false)
+	return brokerDeleteCommand
+}
@@ -0,0 +1,46 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package broker
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/knative/client/pkg/eventing"
+	"github.com/knative/client/pkg/kn/commands"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestBrokerDelete(t *testing.T) {
+	knParams := &commands.KnParams{}
+	broker, err := eventing.ToUnstructured(createMockBroker("default", corev1.ConditionTrue))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd, _, fakeDynamic, buf := commands.CreateTestKnCommandWithDynamic(NewBrokerCommand(knParams), knParams, broker)
+	cmd.SetArgs([]string{"broker", "delete", "default"})
+	err = cmd.Execute()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), "Broker 'default' successfully deleted in namespace 'default'.") {
+		t.Errorf("unexpected output: %s", buf.String())
+	}
+	_, err = fakeDynamic.Resource(eventing.BrokerResource).Namespace("default").Get("default", metav1.GetOptions{})
+	if err == nil {
+		t.Error("expected broker to be deleted")
+	}
+}
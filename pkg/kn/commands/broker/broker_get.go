@@ -0,0 +1,72 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package broker
+
+import (
+	"fmt"
+
+	"github.com/knative/client/pkg/eventing"
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func NewBrokerGetCommand(p *commands.KnParams) *cobra.Command {
+	brokerGetFlags := NewBrokerGetFlags()
+
+	brokerGetCommand := &cobra.Command{
+		Use:   "get",
+		Short: "Get available brokers.",
+		Example: `
+  # Get all brokers in the default namespace
+  kn broker get
+
+  # Get all brokers in all namespaces as YAML
+  kn broker get --all-namespaces -o yaml`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			namespace, err := commands.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.DynamicFactory()
+			if err != nil {
+				return err
+			}
+			list, err := client.Resource(eventing.BrokerResource).Namespace(namespace).List(metav1.ListOptions{})
+			if err != nil {
+				return err
+			}
+			brokers := &eventing.BrokerList{}
+			err = eventing.FromUnstructured(list, brokers)
+			if err != nil {
+				return err
+			}
+			if len(brokers.Items) == 0 {
+				fmt.Fprintf(cmd.OutOrStdout(), "No resources found.\n")
+				return nil
+			}
+			brokers.GetObjectKind().SetGroupVersionKind(eventing.SchemeGroupVersion.WithKind("BrokerList"))
+
+			printer, err := brokerGetFlags.ToPrinter()
+			if err != nil {
+				return err
+			}
+			return printer.PrintObj(brokers, cmd.OutOrStdout())
+		},
+	}
+	commands.AddNamespaceFlags(brokerGetCommand.Flags(), true)
+	brokerGetFlags.AddFlags(brokerGetCommand)
+	return brokerGetCommand
+}
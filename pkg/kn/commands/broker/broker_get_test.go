@@ -0,0 +1,110 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package broker
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/knative/client/pkg/eventing"
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/knative/pkg/apis"
+	duckv1beta1 "github.com/knative/pkg/apis/duck/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	client_testing "k8s.io/client-go/testing"
+)
+
+func fakeBroker(args []string, brokers ...*eventing.Broker) (fakeDynamic *dynamicfake.FakeDynamicClient, output []string, err error) {
+	knParams := &commands.KnParams{}
+	cmd, _, fakeDynamic, buf := commands.CreateTestKnCommandWithDynamic(NewBrokerCommand(knParams), knParams)
+	fakeDynamic.PrependReactor("list", "brokers",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			list := &unstructured.UnstructuredList{}
+			for _, broker := range brokers {
+				obj, err := eventing.ToUnstructured(broker)
+				if err != nil {
+					return true, nil, err
+				}
+				list.Items = append(list.Items, *obj)
+			}
+			return true, list, nil
+		})
+	cmd.SetArgs(args)
+	err = cmd.Execute()
+	output = strings.Split(buf.String(), "\n")
+	return
+}
+
+func createMockBroker(name string, ready corev1.ConditionStatus) *eventing.Broker {
+	broker := &eventing.Broker{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: eventing.SchemeGroupVersion.String(),
+			Kind:       "Broker",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+	}
+	broker.Status.Address.Hostname = name + "-broker.default.svc.cluster.local"
+	broker.Status.Status = duckv1beta1.Status{
+		Conditions: duckv1beta1.Conditions{
+			apis.Condition{Type: apis.ConditionReady, Status: ready, Reason: "NotReady"},
+		},
+	}
+	return broker
+}
+
+func testContains(t *testing.T, output string, sub []string, element string) {
+	for _, each := range sub {
+		if !strings.Contains(output, each) {
+			t.Errorf("Missing %s: %s", element, each)
+		}
+	}
+}
+
+func TestBrokerGetEmpty(t *testing.T) {
+	_, output, err := fakeBroker([]string{"broker", "get"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if output[0] != "No resources found." {
+		t.Errorf("unexpected output: %s", output[0])
+	}
+}
+
+func TestBrokerGet(t *testing.T) {
+	_, output, err := fakeBroker([]string{"broker", "get"},
+		createMockBroker("default", corev1.ConditionTrue), createMockBroker("other", corev1.ConditionFalse))
+	if err != nil {
+		t.Fatal(err)
+	}
+	testContains(t, output[0], []string{"NAME", "URL", "AGE", "CONDITIONS", "READY", "REASON"}, "column header")
+	testContains(t, output[1], []string{"default", "http://default-broker.default.svc.cluster.local", "1 OK / 1", "True"}, "value")
+	testContains(t, output[2], []string{"other", "0 OK / 1", "False", "NotReady"}, "value")
+}
+
+func TestBrokerGetYAML(t *testing.T) {
+	_, output, err := fakeBroker([]string{"broker", "get", "-o", "yaml"}, createMockBroker("default", corev1.ConditionTrue))
+	if err != nil {
+		t.Fatal(err)
+	}
+	yaml := strings.Join(output, "\n")
+	testContains(t, yaml, []string{"kind: BrokerList", "name: default"}, "value")
+}
@@ -0,0 +1,67 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package broker
+
+import (
+	"github.com/knative/client/pkg/eventing"
+	"github.com/knative/client/pkg/kn/commands"
+	hprinters "github.com/knative/client/pkg/printers"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// BrokerGetHandlers adds print handlers for broker get command
+func BrokerGetHandlers(h hprinters.PrintHandler) {
+	brokerColumnDefinitions := []metav1beta1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Description: "Name of the broker."},
+		{Name: "URL", Type: "string", Description: "URL events are sent to."},
+		{Name: "Age", Type: "string", Description: "Age of the broker."},
+		{Name: "Conditions", Type: "string", Description: "Conditions describing statuses of broker components."},
+		{Name: "Ready", Type: "string", Description: "Ready condition status of the broker."},
+		{Name: "Reason", Type: "string", Description: "Reason for non-ready condition of the broker."},
+	}
+	h.TableHandler(brokerColumnDefinitions, printBroker)
+	h.TableHandler(brokerColumnDefinitions, printBrokerList)
+}
+
+// Private functions
+
+// printBrokerList populates the broker list table rows
+func printBrokerList(brokerList *eventing.BrokerList, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
+	rows := make([]metav1beta1.TableRow, 0, len(brokerList.Items))
+	for _, broker := range brokerList.Items {
+		r, err := printBroker(&broker, options)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, r...)
+	}
+	return rows, nil
+}
+
+// printBroker populates the broker table rows
+func printBroker(broker *eventing.Broker, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
+	row := metav1beta1.TableRow{
+		Object: runtime.RawExtension{Object: broker},
+	}
+	row.Cells = append(row.Cells,
+		broker.Name,
+		eventing.BrokerURL(broker),
+		commands.TranslateTimestampSince(broker.CreationTimestamp),
+		commands.ConditionsValue(broker.Status.Conditions),
+		commands.ReadyCondition(broker.Status.Conditions),
+		commands.NonReadyConditionReason(broker.Status.Conditions))
+	return []metav1beta1.TableRow{row}, nil
+}
@@ -0,0 +1,147 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package build
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/knative/build/pkg/apis/build/v1alpha1"
+	"github.com/knative/client/pkg/build"
+	"github.com/knative/client/pkg/kn/commands"
+	hprinters "github.com/knative/client/pkg/printers"
+	"github.com/knative/pkg/apis"
+	duckv1alpha1 "github.com/knative/pkg/apis/duck/v1alpha1"
+	duckv1beta1 "github.com/knative/pkg/apis/duck/v1beta1"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+func NewBuildDescribeCommand(p *commands.KnParams) *cobra.Command {
+	buildDescribePrintFlags := genericclioptions.NewPrintFlags("")
+	buildDescribeCommand := &cobra.Command{
+		Use:   "describe NAME",
+		Short: "Describe a build and the status of its steps.",
+		Example: `
+  # Describe the build 'mybuild' in the default namespace
+  kn build describe mybuild
+
+  # Print the build 'mybuild' as YAML
+  kn build describe mybuild -o yaml`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("requires the build name.")
+			}
+			namespace, err := commands.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.DynamicFactory()
+			if err != nil {
+				return err
+			}
+			obj, err := client.Resource(build.BuildResource).Namespace(namespace).Get(args[0], metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			b, err := build.FromUnstructured(obj)
+			if err != nil {
+				return err
+			}
+
+			if buildDescribePrintFlags.OutputFlagSpecified() {
+				printer, err := buildDescribePrintFlags.ToPrinter()
+				if err != nil {
+					return err
+				}
+				b.GetObjectKind().SetGroupVersionKind(v1alpha1.SchemeGroupVersion.WithKind("Build"))
+				return printer.PrintObj(b, cmd.OutOrStdout())
+			}
+			return describeBuild(cmd.OutOrStdout(), b)
+		},
+	}
+	commands.AddNamespaceFlags(buildDescribeCommand.Flags(), false)
+	buildDescribePrintFlags.AddFlags(buildDescribeCommand)
+	return buildDescribeCommand
+}
+
+func describeBuild(out io.Writer, b *v1alpha1.Build) error {
+	w := hprinters.GetNewTabWriter(out)
+	field := commands.FieldPrinter(w)
+	field("Name", b.Name)
+	field("Namespace", b.Namespace)
+	field("Age", commands.TranslateTimestampSince(b.CreationTimestamp))
+	if b.Spec.Template != nil {
+		field("Template", b.Spec.Template.Name)
+		for _, arg := range b.Spec.Template.Arguments {
+			field("  "+arg.Name, arg.Value)
+		}
+	}
+	if b.Spec.Source != nil && b.Spec.Source.Git != nil {
+		field("Source", b.Spec.Source.Git.Url)
+		field("Revision", b.Spec.Source.Git.Revision)
+	}
+	field("Service Account", b.Spec.ServiceAccountName)
+	if b.Status.Cluster != nil {
+		field("Pod", b.Status.Cluster.PodName)
+	}
+	if b.Status.StartTime != nil {
+		field("Started", commands.TranslateTimestampSince(*b.Status.StartTime))
+	}
+	if b.Status.StartTime != nil && b.Status.CompletionTime != nil {
+		field("Duration", b.Status.CompletionTime.Sub(b.Status.StartTime.Time).String())
+	}
+	err := w.Flush()
+	if err != nil {
+		return err
+	}
+	err = describeSteps(out, b)
+	if err != nil {
+		return err
+	}
+	return describeConditions(out, b.Status.Conditions)
+}
+
+func describeSteps(out io.Writer, b *v1alpha1.Build) error {
+	if len(b.Status.StepStates) == 0 {
+		return nil
+	}
+	fmt.Fprintln(out, "\nSteps:")
+	w := hprinters.GetNewTabWriter(out)
+	fmt.Fprintln(w, "  STEP\tSTATUS")
+	for i, state := range b.Status.StepStates {
+		fmt.Fprintf(w, "  %s\t%s\n", build.StepName(b, i), build.StepStatus(state))
+	}
+	return w.Flush()
+}
+
+// describeConditions prints the conditions of a build, which are still of
+// the v1alpha1 duck type
+func describeConditions(out io.Writer, conditions duckv1alpha1.Conditions) error {
+	converted := make(duckv1beta1.Conditions, 0, len(conditions))
+	for _, condition := range conditions {
+		converted = append(converted, apis.Condition{
+			Type:               apis.ConditionType(condition.Type),
+			Status:             condition.Status,
+			Severity:           apis.ConditionSeverity(condition.Severity),
+			LastTransitionTime: condition.LastTransitionTime,
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+		})
+	}
+	return commands.PrintConditions(out, converted)
+}
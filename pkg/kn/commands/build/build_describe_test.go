@@ -0,0 +1,66 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package build
+
+import (
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestBuildDescribe(t *testing.T) {
+	completed := corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 0}}
+	failed := corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 1, Reason: "Error"}}
+	obj := createMockBuild(t, "foo", corev1.ConditionFalse, completed, failed)
+	_, output, err := fakeBuild([]string{"build", "describe", "foo"}, obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	all := strings.Join(output, "\n")
+	for _, expected := range []string{
+		"Name:", "foo",
+		"Template:", "kaniko", "IMAGE:", "gcr.io/foo/bar",
+		"Source:", "https://github.com/foo/bar",
+		"Pod:", "foo-pod",
+		"STEP", "STATUS",
+		"step-0", "Completed",
+		"step-1", "Failed (Error)",
+		"Succeeded", "False", "Building",
+	} {
+		if !strings.Contains(all, expected) {
+			t.Errorf("missing '%s' in output:\n%s", expected, all)
+		}
+	}
+}
+
+func TestBuildDescribeYAML(t *testing.T) {
+	_, output, err := fakeBuild([]string{"build", "describe", "foo", "-o", "yaml"},
+		createMockBuild(t, "foo", corev1.ConditionTrue))
+	if err != nil {
+		t.Fatal(err)
+	}
+	all := strings.Join(output, "\n")
+	if !strings.Contains(all, "kind: Build") || !strings.Contains(all, "name: foo") {
+		t.Errorf("unexpected output:\n%s", all)
+	}
+}
+
+func TestBuildDescribeNoName(t *testing.T) {
+	_, _, err := fakeBuild([]string{"build", "describe"})
+	if err == nil || err.Error() != "requires the build name." {
+		t.Fatalf("expected error for missing name, got %v", err)
+	}
+}
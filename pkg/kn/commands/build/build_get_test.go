@@ -0,0 +1,96 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package build
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/knative/build/pkg/apis/build/v1alpha1"
+	"github.com/knative/client/pkg/build"
+	"github.com/knative/client/pkg/kn/commands"
+	duckv1alpha1 "github.com/knative/pkg/apis/duck/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	client_testing "k8s.io/client-go/testing"
+)
+
+func fakeBuild(args []string, objects ...runtime.Object) (fakeDynamic *dynamicfake.FakeDynamicClient, output []string, err error) {
+	knParams := &commands.KnParams{}
+	cmd, _, fakeDynamic, buf := commands.CreateTestKnCommandWithDynamic(NewBuildCommand(knParams), knParams, objects...)
+	fakeDynamic.PrependReactor("list", "*",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			list := &unstructured.UnstructuredList{}
+			for _, object := range objects {
+				obj := object.(*unstructured.Unstructured)
+				if strings.ToLower(obj.GetKind())+"s" == a.GetResource().Resource {
+					list.Items = append(list.Items, *obj)
+				}
+			}
+			return true, list, nil
+		})
+	cmd.SetArgs(args)
+	err = cmd.Execute()
+	output = strings.Split(buf.String(), "\n")
+	return
+}
+
+func createMockBuild(t *testing.T, name string, status corev1.ConditionStatus, states ...corev1.ContainerState) *unstructured.Unstructured {
+	b := build.NewGitBuild("default", name, "https://github.com/foo/bar", "master", "kaniko", map[string]string{"IMAGE": "gcr.io/foo/bar"})
+	b.Status.Conditions = duckv1alpha1.Conditions{
+		{Type: v1alpha1.BuildSucceeded, Status: status, Reason: "Building"},
+	}
+	b.Status.StepStates = states
+	b.Status.Cluster = &v1alpha1.ClusterSpec{Namespace: "default", PodName: name + "-pod"}
+	obj, err := build.ToUnstructured(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return obj
+}
+
+func TestBuildGetEmpty(t *testing.T) {
+	_, output, err := fakeBuild([]string{"build", "get"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if output[0] != "No resources found." {
+		t.Errorf("wrong output: %s", output[0])
+	}
+}
+
+func TestBuildGet(t *testing.T) {
+	completed := corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 0}}
+	running := corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}
+	_, output, err := fakeBuild([]string{"build", "get"},
+		createMockBuild(t, "foo", corev1.ConditionUnknown, completed, running),
+		createMockBuild(t, "bar", corev1.ConditionTrue, completed, completed))
+	if err != nil {
+		t.Fatal(err)
+	}
+	testContains(t, output[0], []string{"NAME", "TEMPLATE", "STEPS", "AGE", "SUCCEEDED", "REASON"}, "column header")
+	testContains(t, output[1], []string{"foo", "kaniko", "1/2", "Unknown", "Building"}, "value")
+	testContains(t, output[2], []string{"bar", "kaniko", "2/2", "True"}, "value")
+}
+
+func testContains(t *testing.T, output string, sub []string, element string) {
+	for _, each := range sub {
+		if !strings.Contains(output, each) {
+			t.Errorf("Missing %s: %s", element, each)
+		}
+	}
+}
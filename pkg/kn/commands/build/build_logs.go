@@ -0,0 +1,135 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package build
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/knative/client/pkg/build"
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+// Interval in which the build's pod is checked for the next step to start
+var logPollInterval = time.Second
+
+// Open the log stream of a container, can be replaced in tests
+var streamContainerLogs = func(client corev1client.CoreV1Interface, namespace, pod string, options *corev1.PodLogOptions) (io.ReadCloser, error) {
+	return client.Pods(namespace).GetLogs(pod, options).Stream()
+}
+
+func NewBuildLogsCommand(p *commands.KnParams) *cobra.Command {
+	var follow bool
+
+	buildLogsCommand := &cobra.Command{
+		Use:   "logs NAME",
+		Short: "Print the logs of a build's steps.",
+		Example: `
+  # Print the logs of all steps of the build 'mybuild' which have started so far
+  kn build logs mybuild
+
+  # Stream the logs of the build 'mybuild' until it has finished
+  kn build logs mybuild --follow`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("requires the build name.")
+			}
+			namespace, err := commands.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.DynamicFactory()
+			if err != nil {
+				return err
+			}
+			obj, err := client.Resource(build.BuildResource).Namespace(namespace).Get(args[0], metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			b, err := build.FromUnstructured(obj)
+			if err != nil {
+				return err
+			}
+			if b.Status.Cluster == nil || b.Status.Cluster.PodName == "" {
+				return fmt.Errorf("build '%s' has no pod running its steps yet", b.Name)
+			}
+			coreClient, err := p.CoreFactory()
+			if err != nil {
+				return err
+			}
+			return printStepLogs(coreClient, cmd.OutOrStdout(), namespace, b.Status.Cluster.PodName, follow)
+		},
+	}
+	commands.AddNamespaceFlags(buildLogsCommand.Flags(), false)
+	buildLogsCommand.Flags().BoolVarP(&follow, "follow", "f", false, "Stream the logs of running steps and wait for the following steps to start.")
+	return buildLogsCommand
+}
+
+// printStepLogs prints the logs of the build pod's init containers, which
+// run the build steps one after the other
+func printStepLogs(client corev1client.CoreV1Interface, out io.Writer, namespace, podName string, follow bool) error {
+	pod, err := client.Pods(namespace).Get(podName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	for _, container := range pod.Spec.InitContainers {
+		step := build.StepContainerStepName(container.Name)
+		for follow && !containerStarted(pod, container.Name) && !podFinished(pod) {
+			time.Sleep(logPollInterval)
+			pod, err = client.Pods(namespace).Get(podName, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+		}
+		if !containerStarted(pod, container.Name) {
+			fmt.Fprintf(out, "Step '%s' hasn't started.\n", step)
+			return nil
+		}
+
+		fmt.Fprintf(out, "==> Step '%s' <==\n", step)
+		stream, err := streamContainerLogs(client, namespace, podName, &corev1.PodLogOptions{
+			Container: container.Name,
+			Follow:    follow,
+		})
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(out, stream)
+		stream.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func containerStarted(pod *corev1.Pod, container string) bool {
+	for _, status := range pod.Status.InitContainerStatuses {
+		if status.Name == container {
+			return status.State.Running != nil || status.State.Terminated != nil
+		}
+	}
+	return false
+}
+
+func podFinished(pod *corev1.Pod) bool {
+	return pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed
+}
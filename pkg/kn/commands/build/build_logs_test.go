@@ -0,0 +1,124 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package build
+
+import (
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/knative/client/pkg/kn/commands"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	corefake "k8s.io/client-go/kubernetes/typed/core/v1/fake"
+	client_testing "k8s.io/client-go/testing"
+)
+
+func fakeBuildLogs(t *testing.T, args []string, pods ...*corev1.Pod) (streamed []string, output string, err error) {
+	knParams := &commands.KnParams{}
+	cmd, _, _, buf := commands.CreateTestKnCommandWithDynamic(NewBuildCommand(knParams), knParams,
+		createMockBuild(t, "foo", corev1.ConditionUnknown))
+	fakeCore := &corefake.FakeCoreV1{Fake: &client_testing.Fake{}}
+	knParams.CoreFactory = func() (corev1client.CoreV1Interface, error) { return fakeCore, nil }
+	fakeCore.AddReactor("get", "pods",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			if len(pods) > 1 {
+				pod := pods[0]
+				pods = pods[1:]
+				return true, pod, nil
+			}
+			return true, pods[0], nil
+		})
+
+	oldStream, oldInterval := streamContainerLogs, logPollInterval
+	defer func() { streamContainerLogs, logPollInterval = oldStream, oldInterval }()
+	logPollInterval = 0
+	streamContainerLogs = func(client corev1client.CoreV1Interface, namespace, pod string, options *corev1.PodLogOptions) (io.ReadCloser, error) {
+		streamed = append(streamed, options.Container)
+		return ioutil.NopCloser(strings.NewReader("logs of " + options.Container + "\n")), nil
+	}
+
+	cmd.SetArgs(args)
+	err = cmd.Execute()
+	output = buf.String()
+	return
+}
+
+func newBuildPod(states ...corev1.ContainerState) *corev1.Pod {
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "foo-pod", Namespace: "default"}}
+	for i, name := range []string{"build-step-git-source", "build-step-build", "build-step-push"} {
+		pod.Spec.InitContainers = append(pod.Spec.InitContainers, corev1.Container{Name: name})
+		if i < len(states) {
+			pod.Status.InitContainerStatuses = append(pod.Status.InitContainerStatuses,
+				corev1.ContainerStatus{Name: name, State: states[i]})
+		}
+	}
+	return pod
+}
+
+var (
+	terminated = corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{}}
+	running    = corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}
+	waiting    = corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{}}
+)
+
+func TestBuildLogs(t *testing.T) {
+	streamed, output, err := fakeBuildLogs(t, []string{"build", "logs", "foo"},
+		newBuildPod(terminated, running, waiting))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(streamed, ",") != "build-step-git-source,build-step-build" {
+		t.Errorf("wrong containers streamed: %v", streamed)
+	}
+	expected := `==> Step 'git-source' <==
+logs of build-step-git-source
+==> Step 'build' <==
+logs of build-step-build
+Step 'push' hasn't started.
+`
+	if output != expected {
+		t.Errorf("wrong output:\n%s\nexpected:\n%s", output, expected)
+	}
+}
+
+func TestBuildLogsFollow(t *testing.T) {
+	streamed, _, err := fakeBuildLogs(t, []string{"build", "logs", "foo", "--follow"},
+		newBuildPod(running, waiting, waiting),
+		newBuildPod(terminated, running, waiting),
+		newBuildPod(terminated, terminated, running))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(streamed, ",") != "build-step-git-source,build-step-build,build-step-push" {
+		t.Errorf("wrong containers streamed: %v", streamed)
+	}
+}
+
+func TestBuildLogsFollowFailedPod(t *testing.T) {
+	failed := newBuildPod(terminated, waiting, waiting)
+	failed.Status.Phase = corev1.PodFailed
+	streamed, output, err := fakeBuildLogs(t, []string{"build", "logs", "foo", "-f"},
+		newBuildPod(running, waiting, waiting), failed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(streamed) != 1 || !strings.Contains(output, "Step 'build' hasn't started.") {
+		t.Errorf("unexpected logs %v:\n%s", streamed, output)
+	}
+}
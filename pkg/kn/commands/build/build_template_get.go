@@ -0,0 +1,72 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package build
+
+import (
+	"fmt"
+
+	"github.com/knative/build/pkg/apis/build/v1alpha1"
+	"github.com/knative/client/pkg/build"
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func NewBuildTemplateGetCommand(p *commands.KnParams) *cobra.Command {
+	templateGetFlags := NewBuildGetFlags()
+
+	templateGetCommand := &cobra.Command{
+		Use:   "get",
+		Short: "Get available build templates.",
+		Example: `
+  # Get all build templates in the default namespace
+  kn build template get
+
+  # Get all build templates in all namespaces as YAML
+  kn build template get --all-namespaces -o yaml`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			namespace, err := commands.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.DynamicFactory()
+			if err != nil {
+				return err
+			}
+			list, err := client.Resource(build.BuildTemplateResource).Namespace(namespace).List(metav1.ListOptions{})
+			if err != nil {
+				return err
+			}
+			templates, err := build.TemplateListFromUnstructured(list)
+			if err != nil {
+				return err
+			}
+			if len(templates.Items) == 0 {
+				fmt.Fprintf(cmd.OutOrStdout(), "No resources found.\n")
+				return nil
+			}
+			templates.GetObjectKind().SetGroupVersionKind(v1alpha1.SchemeGroupVersion.WithKind("BuildTemplateList"))
+
+			printer, err := templateGetFlags.ToPrinter()
+			if err != nil {
+				return err
+			}
+			return printer.PrintObj(templates, cmd.OutOrStdout())
+		},
+	}
+	commands.AddNamespaceFlags(templateGetCommand.Flags(), true)
+	templateGetFlags.AddFlags(templateGetCommand)
+	return templateGetCommand
+}
@@ -0,0 +1,73 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package build
+
+import (
+	"testing"
+
+	"github.com/knative/build/pkg/apis/build/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func createMockBuildTemplate(t *testing.T, name string, parameters []string, steps int) *unstructured.Unstructured {
+	template := &v1alpha1.BuildTemplate{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1alpha1.SchemeGroupVersion.String(),
+			Kind:       "BuildTemplate",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+	}
+	for _, parameter := range parameters {
+		template.Spec.Parameters = append(template.Spec.Parameters, v1alpha1.ParameterSpec{Name: parameter})
+	}
+	for i := 0; i < steps; i++ {
+		template.Spec.Steps = append(template.Spec.Steps, corev1.Container{Image: "busybox"})
+	}
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(template)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &unstructured.Unstructured{Object: content}
+}
+
+func TestBuildTemplateGet(t *testing.T) {
+	_, output, err := fakeBuild([]string{"build", "template", "get"},
+		createMockBuildTemplate(t, "kaniko", []string{"IMAGE", "DOCKERFILE"}, 1),
+		createMockBuild(t, "foo", corev1.ConditionTrue))
+	if err != nil {
+		t.Fatal(err)
+	}
+	testContains(t, output[0], []string{"NAME", "PARAMETERS", "STEPS", "AGE"}, "column header")
+	testContains(t, output[1], []string{"kaniko", "IMAGE,DOCKERFILE", "1"}, "value")
+	if output[2] != "" {
+		t.Errorf("unexpected build in output: %s", output[2])
+	}
+}
+
+func TestBuildTemplateGetEmpty(t *testing.T) {
+	_, output, err := fakeBuild([]string{"build", "template", "get"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if output[0] != "No resources found." {
+		t.Errorf("wrong output: %s", output[0])
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package build
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/knative/build/pkg/apis/build/v1alpha1"
+	"github.com/knative/client/pkg/build"
+	"github.com/knative/client/pkg/kn/commands"
+	hprinters "github.com/knative/client/pkg/printers"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// BuildGetHandlers adds print handlers for the build and build template
+// get commands
+func BuildGetHandlers(h hprinters.PrintHandler) {
+	buildColumnDefinitions := []metav1beta1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Description: "Name of the build."},
+		{Name: "Template", Type: "string", Description: "Build template used by the build."},
+		{Name: "Steps", Type: "string", Description: "Completed and started steps of the build."},
+		{Name: "Age", Type: "string", Description: "Age of the build."},
+		{Name: "Succeeded", Type: "string", Description: "Succeeded condition status of the build."},
+		{Name: "Reason", Type: "string", Description: "Reason for the status of the build."},
+	}
+	h.TableHandler(buildColumnDefinitions, printBuild)
+	h.TableHandler(buildColumnDefinitions, printBuildList)
+
+	templateColumnDefinitions := []metav1beta1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Description: "Name of the build template."},
+		{Name: "Parameters", Type: "string", Description: "Parameters of the build template."},
+		{Name: "Steps", Type: "integer", Description: "Number of steps of the build template."},
+		{Name: "Age", Type: "string", Description: "Age of the build template."},
+	}
+	h.TableHandler(templateColumnDefinitions, printBuildTemplate)
+	h.TableHandler(templateColumnDefinitions, printBuildTemplateList)
+}
+
+// Private functions
+
+// printBuildList populates the build list table rows
+func printBuildList(buildList *v1alpha1.BuildList, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
+	rows := make([]metav1beta1.TableRow, 0, len(buildList.Items))
+	for _, b := range buildList.Items {
+		r, err := printBuild(&b, options)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, r...)
+	}
+	return rows, nil
+}
+
+// printBuild populates the build table rows
+func printBuild(b *v1alpha1.Build, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
+	template := ""
+	if b.Spec.Template != nil {
+		template = b.Spec.Template.Name
+	}
+	status, reason := "", ""
+	if cond := build.SucceededCondition(b); cond != nil {
+		status = string(cond.Status)
+		reason = cond.Reason
+	}
+	row := metav1beta1.TableRow{
+		Object: runtime.RawExtension{Object: b},
+	}
+	row.Cells = append(row.Cells,
+		b.Name,
+		template,
+		stepsValue(b),
+		commands.TranslateTimestampSince(b.CreationTimestamp),
+		status,
+		reason)
+	return []metav1beta1.TableRow{row}, nil
+}
+
+// printBuildTemplateList populates the build template list table rows
+func printBuildTemplateList(templateList *v1alpha1.BuildTemplateList, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
+	rows := make([]metav1beta1.TableRow, 0, len(templateList.Items))
+	for _, template := range templateList.Items {
+		r, err := printBuildTemplate(&template, options)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, r...)
+	}
+	return rows, nil
+}
+
+// printBuildTemplate populates the build template table rows
+func printBuildTemplate(template *v1alpha1.BuildTemplate, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
+	parameters := make([]string, 0, len(template.Spec.Parameters))
+	for _, parameter := range template.Spec.Parameters {
+		parameters = append(parameters, parameter.Name)
+	}
+	row := metav1beta1.TableRow{
+		Object: runtime.RawExtension{Object: template},
+	}
+	row.Cells = append(row.Cells,
+		template.Name,
+		strings.Join(parameters, ","),
+		len(template.Spec.Steps),
+		commands.TranslateTimestampSince(template.CreationTimestamp))
+	return []metav1beta1.TableRow{row}, nil
+}
+
+// stepsValue formats the number of completed steps and the number of
+// steps which have been started so far
+func stepsValue(b *v1alpha1.Build) string {
+	if len(b.Status.StepStates) == 0 {
+		return ""
+	}
+	completed := 0
+	for _, state := range b.Status.StepStates {
+		if build.StepStatus(state) == "Completed" {
+			completed++
+		}
+	}
+	return fmt.Sprintf("%d/%d", completed, len(b.Status.StepStates))
+}
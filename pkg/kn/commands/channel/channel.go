@@ -0,0 +1,38 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package channel
+
+import (
+	"github.com/knative/client/pkg/eventing"
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/spf13/cobra"
+)
+
+func NewChannelCommand(p *commands.KnParams) *cobra.Command {
+	channelCmd := &cobra.Command{
+		Use:   "channel",
+		Short: "Channel command group",
+	}
+	channelCmd.AddCommand(NewChannelCreateCommand(p))
+	channelCmd.AddCommand(NewChannelGetCommand(p))
+	channelCmd.AddCommand(NewChannelDeleteCommand(p))
+	return channelCmd
+}
+
+// addKindFlag adds the --kind flag selecting the kind of channels
+func addKindFlag(command *cobra.Command, kind *string) {
+	command.Flags().StringVar(kind, "kind", eventing.DefaultChannelKind,
+		"Kind of the channel, e.g. 'InMemoryChannel' or 'KafkaChannel'.")
+}
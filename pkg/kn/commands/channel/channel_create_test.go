@@ -0,0 +1,60 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package channel
+
+import (
+	"testing"
+
+	"github.com/knative/client/pkg/eventing"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestChannelCreate(t *testing.T) {
+	fakeDynamic, _, output, err := fakeChannel([]string{"channel", "create", "foo", "-n", "ns1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if output[0] != "Channel 'foo' successfully created in namespace 'ns1'." {
+		t.Errorf("unexpected output: %s", output[0])
+	}
+	obj, err := fakeDynamic.Resource(eventing.ChannelResource("InMemoryChannel")).Namespace("ns1").Get("foo", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if obj.GetKind() != "InMemoryChannel" || obj.GetAPIVersion() != "messaging.knative.dev/v1alpha1" {
+		t.Errorf("unexpected type %s %s", obj.GetAPIVersion(), obj.GetKind())
+	}
+}
+
+func TestChannelCreateKind(t *testing.T) {
+	fakeDynamic, _, _, err := fakeChannel([]string{"channel", "create", "foo", "--kind", "KafkaChannel"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	obj, err := fakeDynamic.Resource(eventing.ChannelResource("KafkaChannel")).Namespace("default").Get("foo", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if obj.GetKind() != "KafkaChannel" {
+		t.Errorf("unexpected kind %s", obj.GetKind())
+	}
+}
+
+func TestChannelCreateNoName(t *testing.T) {
+	_, _, _, err := fakeChannel([]string{"channel", "create"})
+	if err == nil || err.Error() != "requires the channel name." {
+		t.Errorf("expected error for missing name, got %v", err)
+	}
+}
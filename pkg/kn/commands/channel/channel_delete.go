@@ -0,0 +1,62 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package channel
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/knative/client/pkg/eventing"
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func NewChannelDeleteCommand(p *commands.KnParams) *cobra.Command {
+	var kind string
+
+	channelDeleteCommand := &cobra.Command{
+		Use:   "delete NAME",
+		Short: "Delete a channel.",
+		Example: `
+  # Delete the in-memory channel 'mychannel' in the default namespace
+  kn channel delete mychannel
+
+  # Delete the Kafka channel 'mychannel'
+  kn channel delete mychannel --kind KafkaChannel`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("requires the channel name.")
+			}
+			namespace, err := commands.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.DynamicFactory()
+			if err != nil {
+				return err
+			}
+			err = client.Resource(eventing.ChannelResource(kind)).Namespace(namespace).Delete(args[0], &metav1.DeleteOptions{})
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Channel '%s' successfully deleted in namespace '%s'.\n", args[0], namespace)
+			return nil
+		},
+	}
+	commands.AddNamespaceFlags(channelDeleteCommand.Flags(), false)
+	addKindFlag(channelDeleteCommand, &kind)
+	return channelDeleteCommand
+}
@@ -0,0 +1,74 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package channel
+
+import (
+	"fmt"
+
+	"github.com/knative/client/pkg/eventing"
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func NewChannelGetCommand(p *commands.KnParams) *cobra.Command {
+	channelGetFlags := NewChannelGetFlags()
+	var kind string
+
+	channelGetCommand := &cobra.Command{
+		Use:   "get",
+		Short: "Get available channels.",
+		Example: `
+  # Get all in-memory channels in the default namespace
+  kn channel get
+
+  # Get all Kafka channels in all namespaces as YAML
+  kn channel get --kind KafkaChannel --all-namespaces -o yaml`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			namespace, err := commands.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.DynamicFactory()
+			if err != nil {
+				return err
+			}
+			list, err := client.Resource(eventing.ChannelResource(kind)).Namespace(namespace).List(metav1.ListOptions{})
+			if err != nil {
+				return err
+			}
+			channels := &eventing.ChannelList{}
+			err = eventing.FromUnstructured(list, channels)
+			if err != nil {
+				return err
+			}
+			if len(channels.Items) == 0 {
+				fmt.Fprintf(cmd.OutOrStdout(), "No resources found.\n")
+				return nil
+			}
+			channels.GetObjectKind().SetGroupVersionKind(eventing.MessagingSchemeGroupVersion.WithKind(kind + "List"))
+
+			printer, err := channelGetFlags.ToPrinter()
+			if err != nil {
+				return err
+			}
+			return printer.PrintObj(channels, cmd.OutOrStdout())
+		},
+	}
+	commands.AddNamespaceFlags(channelGetCommand.Flags(), true)
+	channelGetFlags.AddFlags(channelGetCommand)
+	addKindFlag(channelGetCommand, &kind)
+	return channelGetCommand
+}
@@ -0,0 +1,124 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package channel
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/knative/client/pkg/eventing"
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/knative/pkg/apis"
+	duckv1beta1 "github.com/knative/pkg/apis/duck/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	client_testing "k8s.io/client-go/testing"
+)
+
+// fakeChannel runs a channel command against a fake dynamic client
+// containing the given channels
+func fakeChannel(args []string, channels ...*eventing.Channel) (fakeDynamic *dynamicfake.FakeDynamicClient, action client_testing.Action, output []string, err error) {
+	knParams := &commands.KnParams{}
+	objects := make([]runtime.Object, 0, len(channels))
+	for _, channel := range channels {
+		obj, err := eventing.ToUnstructured(channel)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		objects = append(objects, obj)
+	}
+	cmd, _, fakeDynamic, buf := commands.CreateTestKnCommandWithDynamic(NewChannelCommand(knParams), knParams, objects...)
+	fakeDynamic.PrependReactor("list", "*",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			action = a
+			list := &unstructured.UnstructuredList{}
+			for _, obj := range objects {
+				list.Items = append(list.Items, *obj.(*unstructured.Unstructured))
+			}
+			return true, list, nil
+		})
+	cmd.SetArgs(args)
+	err = cmd.Execute()
+	output = strings.Split(buf.String(), "\n")
+	return
+}
+
+func createMockChannel(name, kind string, ready corev1.ConditionStatus) *eventing.Channel {
+	channel := &eventing.Channel{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: eventing.MessagingSchemeGroupVersion.String(),
+			Kind:       kind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+	}
+	channel.Status.Address.Hostname = name + "-kn-channel.default.svc.cluster.local"
+	channel.Status.Status = duckv1beta1.Status{
+		Conditions: duckv1beta1.Conditions{
+			apis.Condition{Type: apis.ConditionReady, Status: ready, Reason: "DispatcherNotReady"},
+		},
+	}
+	return channel
+}
+
+func testContains(t *testing.T, output string, sub []string, element string) {
+	for _, each := range sub {
+		if !strings.Contains(output, each) {
+			t.Errorf("Missing %s: %s", element, each)
+		}
+	}
+}
+
+func TestChannelGetEmpty(t *testing.T) {
+	_, action, output, err := fakeChannel([]string{"channel", "get"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if action.GetResource() != eventing.ChannelResource("InMemoryChannel") {
+		t.Errorf("unexpected resource %v", action.GetResource())
+	}
+	if output[0] != "No resources found." {
+		t.Errorf("unexpected output: %s", output[0])
+	}
+}
+
+func TestChannelGet(t *testing.T) {
+	_, _, output, err := fakeChannel([]string{"channel", "get"},
+		createMockChannel("foo", "InMemoryChannel", corev1.ConditionTrue),
+		createMockChannel("bar", "InMemoryChannel", corev1.ConditionFalse))
+	if err != nil {
+		t.Fatal(err)
+	}
+	testContains(t, output[0], []string{"NAME", "KIND", "URL", "AGE", "CONDITIONS", "READY", "REASON"}, "column header")
+	testContains(t, output[1], []string{"foo", "InMemoryChannel", "http://foo-kn-channel.default.svc.cluster.local", "1 OK / 1", "True"}, "value")
+	testContains(t, output[2], []string{"bar", "False", "DispatcherNotReady"}, "value")
+}
+
+func TestChannelGetKind(t *testing.T) {
+	_, action, output, err := fakeChannel([]string{"channel", "get", "--kind", "KafkaChannel", "-o", "yaml"},
+		createMockChannel("foo", "KafkaChannel", corev1.ConditionTrue))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if action.GetResource().Resource != "kafkachannels" {
+		t.Errorf("unexpected resource %v", action.GetResource())
+	}
+	testContains(t, strings.Join(output, "\n"), []string{"kind: KafkaChannelList", "kind: KafkaChannel", "name: foo"}, "value")
+}
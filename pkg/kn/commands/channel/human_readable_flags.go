@@ -0,0 +1,69 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package channel
+
+import (
+	"github.com/knative/client/pkg/eventing"
+	"github.com/knative/client/pkg/kn/commands"
+	hprinters "github.com/knative/client/pkg/printers"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ChannelGetHandlers adds print handlers for channel get command
+func ChannelGetHandlers(h hprinters.PrintHandler) {
+	channelColumnDefinitions := []metav1beta1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Description: "Name of the channel."},
+		{Name: "Kind", Type: "string", Description: "Kind of the channel."},
+		{Name: "URL", Type: "string", Description: "URL events are sent to."},
+		{Name: "Age", Type: "string", Description: "Age of the channel."},
+		{Name: "Conditions", Type: "string", Description: "Conditions describing statuses of channel components."},
+		{Name: "Ready", Type: "string", Description: "Ready condition status of the channel."},
+		{Name: "Reason", Type: "string", Description: "Reason for non-ready condition of the channel."},
+	}
+	h.TableHandler(channelColumnDefinitions, printChannel)
+	h.TableHandler(channelColumnDefinitions, printChannelList)
+}
+
+// Private functions
+
+// printChannelList populates the channel list table rows
+func printChannelList(channelList *eventing.ChannelList, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
+	rows := make([]metav1beta1.TableRow, 0, len(channelList.Items))
+	for _, channel := range channelList.Items {
+		r, err := printChannel(&channel, options)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, r...)
+	}
+	return rows, nil
+}
+
+// printChannel populates the channel table rows
+func printChannel(channel *eventing.Channel, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
+	row := metav1beta1.TableRow{
+		Object: runtime.RawExtension{Object: channel},
+	}
+	row.Cells = append(row.Cells,
+		channel.Name,
+		channel.Kind,
+		eventing.AddressURL(channel.Status.Address),
+		commands.TranslateTimestampSince(channel.CreationTimestamp),
+		commands.ConditionsValue(channel.Status.Conditions),
+		commands.ReadyCondition(channel.Status.Conditions),
+		commands.NonReadyConditionReason(channel.Status.Conditions))
+	return []metav1beta1.TableRow{row}, nil
+}
@@ -0,0 +1,32 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configuration
+
+import (
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/spf13/cobra"
+)
+
+func NewConfigurationCommand(p *commands.KnParams) *cobra.Command {
+	configurationCmd := &cobra.Command{
+		Use:   "configuration",
+		Short: "Configuration command group",
+	}
+	configurationCmd.AddCommand(NewConfigurationGetCommand(p))
+	configurationCmd.AddCommand(NewConfigurationDescribeCommand(p))
+	configurationCmd.AddCommand(NewConfigurationCreateCommand(p))
+	configurationCmd.AddCommand(NewConfigurationUpdateCommand(p))
+	return configurationCmd
+}
@@ -0,0 +1,94 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configuration
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/knative/client/pkg/kn/commands/service"
+	servingv1alpha1 "github.com/knative/serving/pkg/apis/serving/v1alpha1"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NewConfigurationCreateCommand represents 'kn configuration create' command
+func NewConfigurationCreateCommand(p *commands.KnParams) *cobra.Command {
+	var editFlags service.ConfigurationEditFlags
+
+	configurationCreateCommand := &cobra.Command{
+		Use:   "create NAME --image IMAGE",
+		Short: "Create a configuration.",
+		Long: `Create a configuration.
+
+Unlike a service, a configuration comes without a route. Use it when you
+manage the routes to its revisions yourself.`,
+		Example: `
+  # Create a configuration 'myconfig' using image at dev.local/ns/image:latest
+  kn configuration create myconfig --image dev.local/ns/image:latest
+
+  # Create a configuration with an environment variable and a memory limit
+  kn configuration create myconfig --image dev.local/ns/image:latest --env KEY1=VALUE1 --limits-memory 512Mi`,
+
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			if len(args) != 1 {
+				return errors.New("requires the configuration name.")
+			}
+			if editFlags.Image == "" {
+				return errors.New("requires the image name to run.")
+			}
+
+			namespace, err := commands.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+
+			configuration := servingv1alpha1.Configuration{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      args[0],
+					Namespace: namespace,
+				},
+				Spec: servingv1alpha1.ConfigurationSpec{
+					DeprecatedRevisionTemplate: &servingv1alpha1.RevisionTemplateSpec{
+						Spec: servingv1alpha1.RevisionSpec{
+							DeprecatedContainer: &corev1.Container{},
+						},
+					},
+				},
+			}
+
+			err = editFlags.ApplyTemplate(configuration.Spec.DeprecatedRevisionTemplate, cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.ServingFactory()
+			if err != nil {
+				return err
+			}
+			_, err = client.Configurations(namespace).Create(&configuration)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Configuration '%s' successfully created in namespace '%s'.\n", args[0], namespace)
+			return nil
+		},
+	}
+	commands.AddNamespaceFlags(configurationCreateCommand.Flags(), false)
+	editFlags.AddTemplateFlags(configurationCreateCommand)
+	configurationCreateCommand.MarkFlagRequired("image")
+	return configurationCreateCommand
+}
@@ -0,0 +1,95 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configuration
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/knative/serving/pkg/apis/serving/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	client_testing "k8s.io/client-go/testing"
+)
+
+func fakeConfigurationCreate(args []string) (
+	action client_testing.Action,
+	created *v1alpha1.Configuration,
+	output string,
+	err error) {
+	knParams := &commands.KnParams{}
+	cmd, fakeServing, buf := commands.CreateTestKnCommand(NewConfigurationCommand(knParams), knParams)
+	fakeServing.AddReactor("create", "configurations",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			createAction, ok := a.(client_testing.CreateAction)
+			action = createAction
+			if !ok {
+				return true, nil, fmt.Errorf("wrong kind of action %v", a)
+			}
+			created, ok = createAction.GetObject().(*v1alpha1.Configuration)
+			if !ok {
+				return true, nil, errors.New("was passed the wrong object")
+			}
+			return true, created, nil
+		})
+	cmd.SetArgs(args)
+	err = cmd.Execute()
+	output = buf.String()
+	return
+}
+
+func TestConfigurationCreateImage(t *testing.T) {
+	action, created, output, err := fakeConfigurationCreate([]string{
+		"configuration", "create", "foo", "--image", "gcr.io/foo/bar:baz",
+		"--env", "A=1", "--limits-memory", "512Mi"})
+	if err != nil {
+		t.Fatal(err)
+	} else if !action.Matches("create", "configurations") {
+		t.Fatalf("Bad action %v", action)
+	}
+	container := created.Spec.DeprecatedRevisionTemplate.Spec.DeprecatedContainer
+	if container.Image != "gcr.io/foo/bar:baz" {
+		t.Errorf("wrong image set: %v", container.Image)
+	}
+	if len(container.Env) != 1 || container.Env[0].Name != "A" || container.Env[0].Value != "1" {
+		t.Errorf("wrong env set: %v", container.Env)
+	}
+	memory := container.Resources.Limits[corev1.ResourceMemory]
+	if memory.Cmp(resource.MustParse("512Mi")) != 0 {
+		t.Errorf("wrong limits set: %v", container.Resources.Limits)
+	}
+	if !strings.Contains(output, "Configuration 'foo' successfully created in namespace 'default'.") {
+		t.Errorf("unexpected output: %s", output)
+	}
+}
+
+func TestConfigurationCreateNoImage(t *testing.T) {
+	_, _, _, err := fakeConfigurationCreate([]string{"configuration", "create", "foo"})
+	if err == nil || !strings.Contains(err.Error(), "image") {
+		t.Fatalf("expected error for missing image, got %v", err)
+	}
+}
+
+func TestConfigurationCreateNoServiceFlags(t *testing.T) {
+	_, _, _, err := fakeConfigurationCreate([]string{
+		"configuration", "create", "foo", "--image", "gcr.io/foo/bar:baz", "--cluster-local"})
+	if err == nil || !strings.Contains(err.Error(), "unknown flag: --cluster-local") {
+		t.Fatalf("expected error for service-only flag, got %v", err)
+	}
+}
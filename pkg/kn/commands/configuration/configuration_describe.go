@@ -0,0 +1,105 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configuration
+
+import (
+	"errors"
+	"io"
+	"strconv"
+
+	"github.com/knative/client/pkg/kn/commands"
+	hprinters "github.com/knative/client/pkg/printers"
+	servinglib "github.com/knative/client/pkg/serving"
+	"github.com/knative/serving/pkg/apis/serving"
+	servingv1alpha1 "github.com/knative/serving/pkg/apis/serving/v1alpha1"
+	"github.com/spf13/cobra"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+// NewConfigurationDescribeCommand represents 'kn configuration describe' command
+func NewConfigurationDescribeCommand(p *commands.KnParams) *cobra.Command {
+	configurationDescribePrintFlags := genericclioptions.NewPrintFlags("")
+	configurationDescribeCommand := &cobra.Command{
+		Use:   "describe NAME",
+		Short: "Describe available configurations.",
+		Example: `
+  # Describe configuration 'svc1' in default namespace
+  kn configuration describe svc1
+
+  # Print configuration 'svc1' as YAML
+  kn configuration describe svc1 -o yaml`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) < 1 {
+				return errors.New("requires the configuration name.")
+			}
+			client, err := p.ServingFactory()
+			if err != nil {
+				return err
+			}
+
+			namespace, err := commands.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			configuration, err := client.Configurations(namespace).Get(args[0], v1.GetOptions{})
+			if err != nil {
+				return err
+			}
+
+			if configurationDescribePrintFlags.OutputFlagSpecified() {
+				printer, err := configurationDescribePrintFlags.ToPrinter()
+				if err != nil {
+					return err
+				}
+				configuration.GetObjectKind().SetGroupVersionKind(servingv1alpha1.SchemeGroupVersion.WithKind("Configuration"))
+				return printer.PrintObj(configuration, cmd.OutOrStdout())
+			}
+			return describeConfiguration(cmd.OutOrStdout(), configuration)
+		},
+	}
+	commands.AddNamespaceFlags(configurationDescribeCommand.Flags(), false)
+	configurationDescribePrintFlags.AddFlags(configurationDescribeCommand)
+	return configurationDescribeCommand
+}
+
+// describeConfiguration prints the image of the configuration, its latest
+// revisions and its conditions
+func describeConfiguration(out io.Writer, configuration *servingv1alpha1.Configuration) error {
+	w := hprinters.GetNewTabWriter(out)
+	field := commands.FieldPrinter(w)
+	field("Name", configuration.Name)
+	field("Namespace", configuration.Namespace)
+	field("Age", commands.TranslateTimestampSince(configuration.CreationTimestamp))
+	field("Service", configuration.Labels[serving.ServiceLabelKey])
+	if configuration.Status.ObservedGeneration != 0 {
+		field("Generation", strconv.FormatInt(configuration.Status.ObservedGeneration, 10))
+	}
+	if template, err := servinglib.GetConfigurationRevisionTemplate(configuration); err == nil {
+		image, err := servinglib.GetUserImage(template)
+		if err != nil {
+			return err
+		}
+		field("Image", image)
+	}
+	field("Latest Created", configuration.Status.LatestCreatedRevisionName)
+	field("Latest Ready", configuration.Status.LatestReadyRevisionName)
+	err := w.Flush()
+	if err != nil {
+		return err
+	}
+
+	return commands.PrintConditions(out, configuration.Status.Conditions)
+}
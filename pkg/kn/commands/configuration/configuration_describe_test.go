@@ -0,0 +1,85 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configuration
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/knative/serving/pkg/apis/serving"
+	"github.com/knative/serving/pkg/apis/serving/v1alpha1"
+	"k8s.io/apimachinery/pkg/runtime"
+	client_testing "k8s.io/client-go/testing"
+	"sigs.k8s.io/yaml"
+)
+
+func fakeConfigurationDescribe(args []string, response *v1alpha1.Configuration) (output string, err error) {
+	knParams := &commands.KnParams{}
+	cmd, fakeServing, buf := commands.CreateTestKnCommand(NewConfigurationCommand(knParams), knParams)
+	fakeServing.AddReactor("get", "configurations",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			return true, response, nil
+		})
+	cmd.SetArgs(args)
+	err = cmd.Execute()
+	output = buf.String()
+	return
+}
+
+func TestConfigurationDescribeWithNoName(t *testing.T) {
+	_, err := fakeConfigurationDescribe([]string{"configuration", "describe"}, &v1alpha1.Configuration{})
+	if err == nil || err.Error() != "requires the configuration name." {
+		t.Fatal("expect to fail with missing configuration name")
+	}
+}
+
+func TestConfigurationDescribe(t *testing.T) {
+	configuration := createMockConfiguration("foo", "foo-00003", "foo-00002", 3)
+	configuration.Labels = map[string]string{serving.ServiceLabelKey: "foo"}
+	output, err := fakeConfigurationDescribe([]string{"configuration", "describe", "foo"}, configuration)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testContains(t, output, []string{
+		"Name:", "Service:", "Generation:", "3",
+		"Image:", "gcr.io/foo/bar:baz",
+		"Latest Created:", "foo-00003",
+		"Latest Ready:", "foo-00002",
+		"Conditions:", "Ready", "True",
+	}, "field")
+}
+
+func TestConfigurationDescribeYaml(t *testing.T) {
+	configuration := createMockConfiguration("foo", "foo-00001", "foo-00001", 1)
+	output, err := fakeConfigurationDescribe([]string{"configuration", "describe", "foo", "-o", "yaml"}, configuration)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var returned v1alpha1.Configuration
+	err = yaml.Unmarshal([]byte(output), &returned)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if returned.APIVersion != "serving.knative.dev/v1alpha1" || returned.Kind != "Configuration" {
+		t.Errorf("Unexpected type %s/%s", returned.APIVersion, returned.Kind)
+	}
+	if returned.Status.LatestReadyRevisionName != "foo-00001" {
+		t.Errorf("Status not printed: %v", returned.Status)
+	}
+	if !strings.Contains(output, "image: gcr.io/foo/bar:baz") {
+		t.Errorf("Spec not printed: %s", output)
+	}
+}
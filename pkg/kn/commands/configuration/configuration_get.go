@@ -0,0 +1,73 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configuration
+
+import (
+	"fmt"
+
+	"github.com/knative/client/pkg/kn/commands"
+	servingv1alpha1 "github.com/knative/serving/pkg/apis/serving/v1alpha1"
+	"github.com/spf13/cobra"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NewConfigurationGetCommand represents 'kn configuration get' command
+func NewConfigurationGetCommand(p *commands.KnParams) *cobra.Command {
+	configurationGetFlags := NewConfigurationGetFlags()
+
+	configurationGetCommand := &cobra.Command{
+		Use:   "get",
+		Short: "Get available configurations.",
+		Example: `
+  # Get all configurations in default namespace
+  kn configuration get
+
+  # Get all configurations in all namespaces as YAML
+  kn configuration get --all-namespaces -o yaml`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := p.ServingFactory()
+			if err != nil {
+				return err
+			}
+			namespace, err := commands.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			configuration, err := client.Configurations(namespace).List(v1.ListOptions{})
+			if err != nil {
+				return err
+			}
+			if len(configuration.Items) == 0 {
+				fmt.Fprintf(cmd.OutOrStdout(), "No resources found.\n")
+				return nil
+			}
+			configuration.GetObjectKind().SetGroupVersionKind(servingv1alpha1.SchemeGroupVersion.WithKind("ConfigurationList"))
+
+			printer, err := configurationGetFlags.ToPrinter()
+			if err != nil {
+				return err
+			}
+
+			err = printer.PrintObj(configuration, cmd.OutOrStdout())
+			if err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+	commands.AddNamespaceFlags(configurationGetCommand.Flags(), true)
+	configurationGetFlags.AddFlags(configurationGetCommand)
+	return configurationGetCommand
+}
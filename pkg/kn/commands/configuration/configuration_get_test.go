@@ -0,0 +1,135 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configuration
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/knative/pkg/apis"
+	duckv1beta1 "github.com/knative/pkg/apis/duck/v1beta1"
+	"github.com/knative/serving/pkg/apis/serving/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	client_testing "k8s.io/client-go/testing"
+)
+
+func fakeConfigurationGet(args []string, response *v1alpha1.ConfigurationList) (action client_testing.Action, output []string, err error) {
+	knParams := &commands.KnParams{}
+	cmd, fakeServing, buf := commands.CreateTestKnCommand(NewConfigurationCommand(knParams), knParams)
+	fakeServing.AddReactor("list", "configurations",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			action = a
+			return true, response, nil
+		})
+	cmd.SetArgs(args)
+	err = cmd.Execute()
+	if err != nil {
+		return
+	}
+	output = strings.Split(buf.String(), "\n")
+	return
+}
+
+func TestConfigurationGetEmpty(t *testing.T) {
+	action, output, err := fakeConfigurationGet([]string{"configuration", "get"}, &v1alpha1.ConfigurationList{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if action == nil {
+		t.Errorf("No action")
+	} else if !action.Matches("list", "configurations") {
+		t.Errorf("Bad action %v", action)
+	} else if output[0] != "No resources found." {
+		t.Errorf("Bad output %s", output[0])
+	}
+}
+
+func TestConfigurationGetDefaultOutput(t *testing.T) {
+	configuration1 := createMockConfiguration("foo", "foo-00003", "foo-00002", 3)
+	configuration2 := createMockConfiguration("bar", "bar-00001", "bar-00001", 1)
+	configurationList := &v1alpha1.ConfigurationList{Items: []v1alpha1.Configuration{*configuration1, *configuration2}}
+	_, output, err := fakeConfigurationGet([]string{"configuration", "get"}, configurationList)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testContains(t, output[0], []string{"NAME", "LATEST CREATED", "LATEST READY", "GENERATION", "AGE", "CONDITIONS", "READY", "REASON"}, "column header")
+	testContains(t, output[1], []string{"foo", "foo-00003", "foo-00002", "3"}, "value")
+	testContains(t, output[2], []string{"bar", "bar-00001", "1"}, "value")
+}
+
+func TestConfigurationGetAllNamespaces(t *testing.T) {
+	configuration := createMockConfiguration("foo", "foo-00001", "foo-00001", 1)
+	action, _, err := fakeConfigurationGet([]string{"configuration", "get", "--all-namespaces"},
+		&v1alpha1.ConfigurationList{Items: []v1alpha1.Configuration{*configuration}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if action.GetNamespace() != "" {
+		t.Errorf("Expected listing in all namespaces, got %s", action.GetNamespace())
+	}
+}
+
+func TestConfigurationGetYaml(t *testing.T) {
+	configuration := createMockConfiguration("foo", "foo-00001", "foo-00001", 1)
+	_, output, err := fakeConfigurationGet([]string{"configuration", "get", "-o", "yaml"},
+		&v1alpha1.ConfigurationList{Items: []v1alpha1.Configuration{*configuration}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	testContains(t, strings.Join(output, "\n"), []string{"apiVersion: serving.knative.dev/v1alpha1", "kind: ConfigurationList", "latestCreatedRevisionName: foo-00001"}, "yaml")
+}
+
+func testContains(t *testing.T, output string, sub []string, element string) {
+	for _, each := range sub {
+		if !strings.Contains(output, each) {
+			t.Errorf("Missing %s: %s", element, each)
+		}
+	}
+}
+
+func createMockConfiguration(name, latestCreated, latestReady string, generation int64) *v1alpha1.Configuration {
+	return &v1alpha1.Configuration{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Configuration",
+			APIVersion: "knative.dev/v1alpha1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+		Spec: v1alpha1.ConfigurationSpec{
+			DeprecatedRevisionTemplate: &v1alpha1.RevisionTemplateSpec{
+				Spec: v1alpha1.RevisionSpec{
+					DeprecatedContainer: &corev1.Container{Image: "gcr.io/foo/bar:baz"},
+				},
+			},
+		},
+		Status: v1alpha1.ConfigurationStatus{
+			Status: duckv1beta1.Status{
+				ObservedGeneration: generation,
+				Conditions: duckv1beta1.Conditions{
+					apis.Condition{Type: apis.ConditionReady, Status: corev1.ConditionTrue},
+				},
+			},
+			ConfigurationStatusFields: v1alpha1.ConfigurationStatusFields{
+				LatestCreatedRevisionName: latestCreated,
+				LatestReadyRevisionName:   latestReady,
+			},
+		},
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configuration
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/knative/client/pkg/kn/commands/service"
+	servinglib "github.com/knative/client/pkg/serving"
+	"github.com/knative/serving/pkg/apis/serving"
+	"github.com/spf13/cobra"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NewConfigurationUpdateCommand represents 'kn configuration update' command
+func NewConfigurationUpdateCommand(p *commands.KnParams) *cobra.Command {
+	var editFlags service.ConfigurationEditFlags
+
+	configurationUpdateCommand := &cobra.Command{
+		Use:   "update NAME",
+		Short: "Update a configuration.",
+		Long: `Update a configuration.
+
+Configurations owned by a service are changed by the service controller
+and have to be updated with 'kn service update' instead.`,
+		Example: `
+  # Update configuration 'myconfig' to run a new image
+  kn configuration update myconfig --image dev.local/ns/image:v2
+
+  # Update configuration 'myconfig' with new requests and limits parameters
+  kn configuration update myconfig --requests-cpu 500m --limits-memory 1024Mi`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			if len(args) != 1 {
+				return errors.New("requires the configuration name.")
+			}
+
+			namespace, err := commands.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+
+			client, err := p.ServingFactory()
+			if err != nil {
+				return err
+			}
+
+			configuration, err := client.Configurations(namespace).Get(args[0], v1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			if serviceName := configuration.Labels[serving.ServiceLabelKey]; serviceName != "" {
+				return fmt.Errorf("configuration '%s' is owned by service '%s', please use 'kn service update' instead.", args[0], serviceName)
+			}
+			configuration = configuration.DeepCopy()
+
+			template, err := servinglib.GetConfigurationRevisionTemplate(configuration)
+			if err != nil {
+				return err
+			}
+			err = editFlags.ApplyTemplate(template, cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = client.Configurations(namespace).Update(configuration)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Configuration '%s' successfully updated in namespace '%s'.\n", args[0], namespace)
+			return nil
+		},
+	}
+	commands.AddNamespaceFlags(configurationUpdateCommand.Flags(), false)
+	editFlags.AddTemplateFlags(configurationUpdateCommand)
+	return configurationUpdateCommand
+}
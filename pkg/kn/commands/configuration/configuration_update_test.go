@@ -0,0 +1,91 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configuration
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/knative/serving/pkg/apis/serving"
+	"github.com/knative/serving/pkg/apis/serving/v1alpha1"
+	"k8s.io/apimachinery/pkg/runtime"
+	client_testing "k8s.io/client-go/testing"
+)
+
+func fakeConfigurationUpdate(original *v1alpha1.Configuration, args []string) (
+	updated *v1alpha1.Configuration,
+	output string,
+	err error) {
+	knParams := &commands.KnParams{}
+	cmd, fakeServing, buf := commands.CreateTestKnCommand(NewConfigurationCommand(knParams), knParams)
+	fakeServing.AddReactor("update", "configurations",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			updateAction, ok := a.(client_testing.UpdateAction)
+			if !ok {
+				return true, nil, fmt.Errorf("wrong kind of action %v", a)
+			}
+			updated, ok = updateAction.GetObject().(*v1alpha1.Configuration)
+			if !ok {
+				return true, nil, errors.New("was passed the wrong object")
+			}
+			return true, updated, nil
+		})
+	fakeServing.AddReactor("get", "configurations",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			return true, original, nil
+		})
+	cmd.SetArgs(args)
+	err = cmd.Execute()
+	output = buf.String()
+	return
+}
+
+func TestConfigurationUpdateImage(t *testing.T) {
+	original := createMockConfiguration("foo", "foo-00001", "foo-00001", 1)
+	updated, output, err := fakeConfigurationUpdate(original, []string{
+		"configuration", "update", "foo", "--image", "gcr.io/foo/quux:xyzzy", "--concurrency-limit", "10"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := updated.Spec.DeprecatedRevisionTemplate
+	if template.Spec.DeprecatedContainer.Image != "gcr.io/foo/quux:xyzzy" {
+		t.Errorf("wrong image set: %v", template.Spec.DeprecatedContainer.Image)
+	}
+	if template.Spec.ContainerConcurrency != 10 {
+		t.Errorf("wrong concurrency limit set: %v", template.Spec.ContainerConcurrency)
+	}
+	if original.Spec.DeprecatedRevisionTemplate.Spec.DeprecatedContainer.Image != "gcr.io/foo/bar:baz" {
+		t.Errorf("original configuration modified")
+	}
+	if !strings.Contains(output, "Configuration 'foo' successfully updated in namespace 'default'.") {
+		t.Errorf("unexpected output: %s", output)
+	}
+}
+
+func TestConfigurationUpdateOwnedByService(t *testing.T) {
+	original := createMockConfiguration("foo", "foo-00001", "foo-00001", 1)
+	original.Labels = map[string]string{serving.ServiceLabelKey: "foo"}
+	updated, _, err := fakeConfigurationUpdate(original, []string{
+		"configuration", "update", "foo", "--image", "gcr.io/foo/quux:xyzzy"})
+	if err == nil || err.Error() != "configuration 'foo' is owned by service 'foo', please use 'kn service update' instead." {
+		t.Fatalf("expected error for configuration of a service, got %v", err)
+	}
+	if updated != nil {
+		t.Errorf("configuration of a service updated")
+	}
+}
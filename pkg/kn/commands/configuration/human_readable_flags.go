@@ -0,0 +1,80 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configuration
+
+import (
+	"github.com/knative/client/pkg/kn/commands"
+	hprinters "github.com/knative/client/pkg/printers"
+	servingv1alpha1 "github.com/knative/serving/pkg/apis/serving/v1alpha1"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ConfigurationGetHandlers adds print handlers for configuration get command
+func ConfigurationGetHandlers(h hprinters.PrintHandler) {
+	configurationColumnDefinitions := []metav1beta1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Description: "Name of the configuration."},
+		{Name: "Latest Created", Type: "string", Description: "Name of last revision created."},
+		{Name: "Latest Ready", Type: "string", Description: "Name of last ready revision."},
+		{Name: "Generation", Type: "integer", Description: "Sequence number of 'Generation' of the configuration that was last processed by the controller."},
+		{Name: "Age", Type: "string", Description: "Age of the configuration."},
+		{Name: "Conditions", Type: "string", Description: "Conditions describing statuses of configuration components."},
+		{Name: "Ready", Type: "string", Description: "Ready condition status of the configuration."},
+		{Name: "Reason", Type: "string", Description: "Reason for non-ready condition of the configuration."},
+	}
+	h.TableHandler(configurationColumnDefinitions, printConfiguration)
+	h.TableHandler(configurationColumnDefinitions, printConfigurationList)
+}
+
+// Private functions
+
+// printConfigurationList populates the configuration list table rows
+func printConfigurationList(configurationList *servingv1alpha1.ConfigurationList, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
+	rows := make([]metav1beta1.TableRow, 0, len(configurationList.Items))
+	for _, configuration := range configurationList.Items {
+		r, err := printConfiguration(&configuration, options)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, r...)
+	}
+	return rows, nil
+}
+
+// printConfiguration populates the configuration table rows
+func printConfiguration(configuration *servingv1alpha1.Configuration, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
+	name := configuration.Name
+	latestCreated := configuration.Status.LatestCreatedRevisionName
+	latestReady := configuration.Status.LatestReadyRevisionName
+	generation := configuration.Status.ObservedGeneration
+	age := commands.TranslateTimestampSince(configuration.CreationTimestamp)
+	conditions := commands.ConditionsValue(configuration.Status.Conditions)
+	ready := commands.ReadyCondition(configuration.Status.Conditions)
+	reason := commands.NonReadyConditionReason(configuration.Status.Conditions)
+
+	row := metav1beta1.TableRow{
+		Object: runtime.RawExtension{Object: configuration},
+	}
+	row.Cells = append(row.Cells,
+		name,
+		latestCreated,
+		latestReady,
+		generation,
+		age,
+		conditions,
+		ready,
+		reason)
+	return []metav1beta1.TableRow{row}, nil
+}
@@ -0,0 +1,29 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"io/ioutil"
+	"strings"
+)
+
+// ReadData returns the given data, or the content of a file if the data
+// starts with '@'
+func ReadData(data string) ([]byte, error) {
+	if strings.HasPrefix(data, "@") {
+		return ioutil.ReadFile(strings.TrimPrefix(data, "@"))
+	}
+	return []byte(data), nil
+}
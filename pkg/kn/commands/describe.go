@@ -0,0 +1,56 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"fmt"
+	"io"
+
+	hprinters "github.com/knative/client/pkg/printers"
+	duckv1beta1 "github.com/knative/pkg/apis/duck/v1beta1"
+)
+
+// FieldPrinter returns a function printing 'label: value' lines of a
+// describe command to the given tab writer. Empty values are skipped.
+func FieldPrinter(w io.Writer) func(label, value string) {
+	return func(label, value string) {
+		if value != "" {
+			fmt.Fprintf(w, "%s:\t%s\n", label, value)
+		}
+	}
+}
+
+// PrintConditions prints the conditions of a resource as a table below a
+// 'Conditions:' heading. Nothing is printed if there are no conditions.
+func PrintConditions(out io.Writer, conditions duckv1beta1.Conditions) error {
+	if len(conditions) == 0 {
+		return nil
+	}
+	fmt.Fprintln(out, "\nConditions:")
+	w := hprinters.GetNewTabWriter(out)
+	fmt.Fprintln(w, "  TYPE\tSTATUS\tAGE\tREASON")
+	for _, condition := range conditions {
+		reason := condition.Reason
+		if condition.Message != "" {
+			reason = fmt.Sprintf("%s : %s", reason, condition.Message)
+		}
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\n",
+			condition.Type,
+			condition.Status,
+			TranslateTimestampSince(condition.LastTransitionTime.Inner),
+			reason)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,59 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/knative/pkg/apis"
+	duckv1beta1 "github.com/knative/pkg/apis/duck/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestFieldPrinter(t *testing.T) {
+	out := &bytes.Buffer{}
+	field := FieldPrinter(out)
+	field("Name", "foo")
+	field("Empty", "")
+	if out.String() != "Name:\tfoo\n" {
+		t.Errorf("unexpected output: %q", out.String())
+	}
+}
+
+func TestPrintConditions(t *testing.T) {
+	out := &bytes.Buffer{}
+	err := PrintConditions(out, nil)
+	if err != nil || out.Len() != 0 {
+		t.Fatalf("unexpected output %q or error %v for no conditions", out.String(), err)
+	}
+
+	err = PrintConditions(out, duckv1beta1.Conditions{
+		{Type: apis.ConditionReady, Status: corev1.ConditionFalse, Reason: "ContainerMissing", Message: "image not found"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 3 || lines[0] != "Conditions:" {
+		t.Fatalf("unexpected output:\n%s", out.String())
+	}
+	for _, expected := range []string{"Ready", "False", "<unknown>", "ContainerMissing : image not found"} {
+		if !strings.Contains(lines[2], expected) {
+			t.Errorf("missing '%s' in %s", expected, lines[2])
+		}
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/knative/client/pkg/kn/commands"
+	servinglib "github.com/knative/client/pkg/serving"
+	serving_v1alpha1_client "github.com/knative/serving/pkg/client/clientset/versioned/typed/serving/v1alpha1"
+	"github.com/spf13/cobra"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+func NewDomainCommand(p *commands.KnParams) *cobra.Command {
+	domainCmd := &cobra.Command{
+		Use:   "domain",
+		Short: "Domain command group",
+		Long: `Manage the domains of routes.
+
+The domains are configured cluster wide in the ConfigMap 'config-domain' of
+namespace 'knative-serving'. A route gets the domain whose selector matches
+its labels; the most specific selector wins.`,
+	}
+	domainCmd.AddCommand(NewDomainListCommand(p))
+	domainCmd.AddCommand(NewDomainSetCommand(p))
+	domainCmd.AddCommand(NewDomainUnsetCommand(p))
+	return domainCmd
+}
+
+// getDomainRules reads the domain rules from the domain ConfigMap
+func getDomainRules(client corev1.CoreV1Interface) ([]servinglib.DomainRule, error) {
+	configMap, err := client.ConfigMaps(servinglib.ServingNamespace).Get(servinglib.DomainConfigMapName, v1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return servinglib.ParseDomainConfig(configMap.Data)
+}
+
+// patchDomainConfig sets the given keys of the domain ConfigMap, removing
+// the keys with a nil value
+func patchDomainConfig(client corev1.CoreV1Interface, data map[string]*string) error {
+	patch, err := json.Marshal(map[string]interface{}{"data": data})
+	if err != nil {
+		return err
+	}
+	_, err = client.ConfigMaps(servinglib.ServingNamespace).Patch(servinglib.DomainConfigMapName, types.MergePatchType, patch)
+	return err
+}
+
+// servicesByDomain lists the services of all namespaces, as "namespace/name",
+// by the domain their routes get with the given rules. Cluster-local
+// services don't get a domain.
+func servicesByDomain(client serving_v1alpha1_client.ServingV1alpha1Interface, rules []servinglib.DomainRule) (map[string][]string, error) {
+	services, err := client.Services("").List(v1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	byDomain := map[string][]string{}
+	for i := range services.Items {
+		service := &services.Items[i]
+		if servinglib.IsClusterLocal(service) {
+			continue
+		}
+		domain := servinglib.DomainForLabels(rules, service.Labels)
+		byDomain[domain] = append(byDomain[domain], service.Namespace+"/"+service.Name)
+	}
+	return byDomain, nil
+}
+
+// parseSelector parses selectors of the form 'key=value[,key=value]'. Only
+// equality is supported, as the domain rules select routes by label values.
+func parseSelector(selectors []string) (map[string]string, error) {
+	selector, err := labels.ConvertSelectorToLabelsMap(strings.Join(selectors, ","))
+	if err != nil {
+		return nil, fmt.Errorf("invalid selector '%s', expected 'key=value[,key=value]': %v", strings.Join(selectors, ","), err)
+	}
+	return selector, nil
+}
+
+func formatSelector(selector map[string]string) string {
+	if len(selector) == 0 {
+		return "<default>"
+	}
+	return labels.Set(selector).String()
+}
@@ -0,0 +1,62 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/knative/client/pkg/kn/commands"
+	hprinters "github.com/knative/client/pkg/printers"
+	"github.com/spf13/cobra"
+)
+
+// NewDomainListCommand represents 'kn domain list' command
+func NewDomainListCommand(p *commands.KnParams) *cobra.Command {
+	domainListCommand := &cobra.Command{
+		Use:   "list",
+		Short: "List the domains and the services they apply to.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			coreClient, err := p.CoreFactory()
+			if err != nil {
+				return err
+			}
+			rules, err := getDomainRules(coreClient)
+			if err != nil {
+				return err
+			}
+			if len(rules) == 0 {
+				fmt.Fprintf(cmd.OutOrStdout(), "No domains configured.\n")
+				return nil
+			}
+			client, err := p.ServingFactory()
+			if err != nil {
+				return err
+			}
+			services, err := servicesByDomain(client, rules)
+			if err != nil {
+				return err
+			}
+
+			w := hprinters.GetNewTabWriter(cmd.OutOrStdout())
+			fmt.Fprintln(w, "DOMAIN\tSELECTOR\tSERVICES")
+			for _, rule := range rules {
+				fmt.Fprintf(w, "%s\t%s\t%s\n", rule.Domain, formatSelector(rule.Selector), strings.Join(services[rule.Domain], ", "))
+			}
+			return w.Flush()
+		},
+	}
+	return domainListCommand
+}
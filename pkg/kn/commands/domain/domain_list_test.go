@@ -0,0 +1,116 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/knative/client/pkg/kn/commands"
+	servinglib "github.com/knative/client/pkg/serving"
+	"github.com/knative/serving/pkg/apis/serving/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	client_testing "k8s.io/client-go/testing"
+)
+
+// fakeDomain runs a domain command against a fake domain ConfigMap with
+// the given data and the given services. The data of a merge patch of the
+// ConfigMap is returned.
+func fakeDomain(args []string, data map[string]string, services ...v1alpha1.Service) (
+	patch map[string]*string,
+	output string,
+	err error) {
+	knParams := &commands.KnParams{}
+	cmd, fakeServing, fakeCore, buf := commands.CreateTestKnCommandWithCore(NewDomainCommand(knParams), knParams)
+	fakeCore.AddReactor("get", "configmaps",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			return true, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: a.(client_testing.GetAction).GetName(), Namespace: a.GetNamespace()},
+				Data:       data,
+			}, nil
+		})
+	fakeCore.AddReactor("patch", "configmaps",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			patchAction := a.(client_testing.PatchAction)
+			var body struct {
+				Data map[string]*string `json:"data"`
+			}
+			err := json.Unmarshal(patchAction.GetPatch(), &body)
+			if err != nil {
+				return true, nil, err
+			}
+			patch = body.Data
+			return true, &corev1.ConfigMap{}, nil
+		})
+	fakeServing.AddReactor("list", "services",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			return true, &v1alpha1.ServiceList{Items: services}, nil
+		})
+	cmd.SetArgs(args)
+	err = cmd.Execute()
+	output = buf.String()
+	return
+}
+
+func newDomainTestService(namespace, name string, labels map[string]string) v1alpha1.Service {
+	return v1alpha1.Service{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels}}
+}
+
+func TestDomainList(t *testing.T) {
+	data := map[string]string{
+		"example.com":      "",
+		"prod.example.com": "selector:\n  env: prod\n",
+		"_example":         "documentation",
+	}
+	_, output, err := fakeDomain([]string{"domain", "list"}, data,
+		newDomainTestService("default", "a", nil),
+		newDomainTestService("shop", "b", map[string]string{"env": "prod"}),
+		newDomainTestService("shop", "c", map[string]string{"env": "prod", servinglib.VisibilityLabelKey: servinglib.VisibilityClusterLocal}),
+		newDomainTestService("shop", "d", map[string]string{"env": "dev"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(output, "\n")
+	if len(lines) != 4 {
+		t.Fatalf("unexpected output: %s", output)
+	}
+	testContains(t, lines[0], []string{"DOMAIN", "SELECTOR", "SERVICES"}, "column header")
+	testContains(t, lines[1], []string{"example.com", "<default>", "default/a, shop/d"}, "value")
+	testContains(t, lines[2], []string{"prod.example.com", "env=prod", "shop/b"}, "value")
+	if strings.Contains(output, "shop/c") || strings.Contains(output, "_example") {
+		t.Errorf("unexpected output: %s", output)
+	}
+}
+
+func TestDomainListEmpty(t *testing.T) {
+	_, output, err := fakeDomain([]string{"domain", "list"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if output != "No domains configured.\n" {
+		t.Errorf("unexpected output: %s", output)
+	}
+}
+
+func testContains(t *testing.T, output string, sub []string, element string) {
+	for _, each := range sub {
+		if !strings.Contains(output, each) {
+			t.Errorf("Missing %s: %s", element, each)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/knative/client/pkg/kn/commands"
+	servinglib "github.com/knative/client/pkg/serving"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// NewDomainSetCommand represents 'kn domain set' command
+func NewDomainSetCommand(p *commands.KnParams) *cobra.Command {
+	var selectors []string
+
+	domainSetCommand := &cobra.Command{
+		Use:   "set DOMAIN",
+		Short: "Set a domain for routes.",
+		Long: `Set a domain for routes.
+
+Without a selector the domain becomes the default domain of the cluster,
+replacing the current default domain.`,
+		Example: `
+  # Use domain 'example.com' for all routes without a more specific domain
+  kn domain set example.com
+
+  # Use domain 'prod.example.com' for the routes of services labelled 'env=prod'
+  kn domain set prod.example.com --selector env=prod`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("requires the domain.")
+			}
+			domain := args[0]
+			if errs := validation.IsDNS1123Subdomain(domain); len(errs) > 0 {
+				return fmt.Errorf("invalid domain '%s': %s", domain, strings.Join(errs, ", "))
+			}
+			selector, err := parseSelector(selectors)
+			if err != nil {
+				return err
+			}
+			value, err := servinglib.FormatDomainSelector(selector)
+			if err != nil {
+				return err
+			}
+
+			coreClient, err := p.CoreFactory()
+			if err != nil {
+				return err
+			}
+			rules, err := getDomainRules(coreClient)
+			if err != nil {
+				return err
+			}
+			data := map[string]*string{domain: &value}
+			updated := []servinglib.DomainRule{{Domain: domain, Selector: selector}}
+			for _, rule := range rules {
+				switch {
+				case rule.Domain == domain:
+				case len(selector) == 0 && len(rule.Selector) == 0:
+					// there is only one default domain
+					data[rule.Domain] = nil
+				default:
+					updated = append(updated, rule)
+				}
+			}
+			err = patchDomainConfig(coreClient, data)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Domain '%s' successfully set for selector '%s'.\n", domain, formatSelector(selector))
+
+			client, err := p.ServingFactory()
+			if err != nil {
+				return err
+			}
+			services, err := servicesByDomain(client, updated)
+			if err != nil {
+				return err
+			}
+			if len(services[domain]) == 0 {
+				fmt.Fprintf(cmd.OutOrStdout(), "It applies to no service yet.\n")
+			} else {
+				fmt.Fprintf(cmd.OutOrStdout(), "It applies to services: %s\n", strings.Join(services[domain], ", "))
+			}
+			return nil
+		},
+	}
+	domainSetCommand.Flags().StringArrayVar(&selectors, "selector", []string{},
+		"Label selector 'key=value' of the routes to use the domain for; you may provide this flag "+
+			"any number of times, all labels have to match.")
+	return domainSetCommand
+}
@@ -0,0 +1,72 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDomainSetWithSelector(t *testing.T) {
+	patch, output, err := fakeDomain([]string{"domain", "set", "prod.example.com", "--selector", "env=prod", "--selector", "team=a"},
+		map[string]string{"example.com": ""},
+		newDomainTestService("default", "a", map[string]string{"env": "prod", "team": "a"}),
+		newDomainTestService("default", "b", map[string]string{"env": "prod"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(patch) != 1 || patch["prod.example.com"] == nil {
+		t.Fatalf("wrong patch %v", patch)
+	}
+	value := *patch["prod.example.com"]
+	if value != "selector:\n  env: prod\n  team: a\n" {
+		t.Errorf("wrong selector %q", value)
+	}
+	testContains(t, output, []string{
+		"Domain 'prod.example.com' successfully set for selector 'env=prod,team=a'.",
+		"It applies to services: default/a\n",
+	}, "output")
+}
+
+func TestDomainSetDefault(t *testing.T) {
+	patch, output, err := fakeDomain([]string{"domain", "set", "example.org"},
+		map[string]string{"example.com": "", "prod.example.com": "selector:\n  env: prod\n"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(patch) != 2 || patch["example.com"] != nil || patch["example.org"] == nil || *patch["example.org"] != "" {
+		t.Errorf("wrong patch %v", patch)
+	}
+	testContains(t, output, []string{
+		"Domain 'example.org' successfully set for selector '<default>'.",
+		"It applies to no service yet.",
+	}, "output")
+}
+
+func TestDomainSetInvalid(t *testing.T) {
+	for _, args := range [][]string{
+		{"domain", "set", "Example_com"},
+		{"domain", "set", "example.com", "--selector", "env!=prod"},
+		{"domain", "set", "example.com", "--selector", "env"},
+	} {
+		patch, _, err := fakeDomain(args, map[string]string{})
+		if err == nil || !strings.HasPrefix(err.Error(), "invalid") {
+			t.Errorf("expected error for %v, got %v", args, err)
+		}
+		if patch != nil {
+			t.Errorf("ConfigMap patched for %v", args)
+		}
+	}
+}
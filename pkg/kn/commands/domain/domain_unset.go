@@ -0,0 +1,63 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/spf13/cobra"
+)
+
+// NewDomainUnsetCommand represents 'kn domain unset' command
+func NewDomainUnsetCommand(p *commands.KnParams) *cobra.Command {
+	domainUnsetCommand := &cobra.Command{
+		Use:   "unset DOMAIN",
+		Short: "Remove a domain.",
+		Example: `
+  # Stop using domain 'prod.example.com'
+  kn domain unset prod.example.com`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("requires the domain.")
+			}
+			domain := args[0]
+
+			coreClient, err := p.CoreFactory()
+			if err != nil {
+				return err
+			}
+			rules, err := getDomainRules(coreClient)
+			if err != nil {
+				return err
+			}
+			found := false
+			for _, rule := range rules {
+				found = found || rule.Domain == domain
+			}
+			if !found {
+				return fmt.Errorf("domain '%s' is not configured.", domain)
+			}
+			err = patchDomainConfig(coreClient, map[string]*string{domain: nil})
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Domain '%s' successfully unset.\n", domain)
+			return nil
+		},
+	}
+	return domainUnsetCommand
+}
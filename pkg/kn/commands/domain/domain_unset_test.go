@@ -0,0 +1,43 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"testing"
+)
+
+func TestDomainUnset(t *testing.T) {
+	patch, output, err := fakeDomain([]string{"domain", "unset", "prod.example.com"},
+		map[string]string{"example.com": "", "prod.example.com": "selector:\n  env: prod\n"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value, ok := patch["prod.example.com"]; len(patch) != 1 || !ok || value != nil {
+		t.Errorf("wrong patch %v", patch)
+	}
+	if output != "Domain 'prod.example.com' successfully unset.\n" {
+		t.Errorf("unexpected output: %s", output)
+	}
+}
+
+func TestDomainUnsetUnknown(t *testing.T) {
+	patch, _, err := fakeDomain([]string{"domain", "unset", "prod.example.com"}, map[string]string{"example.com": ""})
+	if err == nil || err.Error() != "domain 'prod.example.com' is not configured." {
+		t.Fatalf("expected error for unknown domain, got %v", err)
+	}
+	if patch != nil {
+		t.Errorf("ConfigMap patched")
+	}
+}
@@ -0,0 +1,252 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package event
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/knative/client/pkg/eventing"
+	"github.com/knative/client/pkg/kn/commands"
+	hprinters "github.com/knative/client/pkg/printers"
+	"github.com/spf13/cobra"
+)
+
+// Time to wait for in-flight requests when exiting after --count events
+const shutdownTimeout = 5 * time.Second
+
+type listenFlags struct {
+	Port             int
+	Output           string
+	Count            int
+	ReplyType        string
+	ReplySource      string
+	ReplyData        string
+	ReplyContentType string
+}
+
+// NewEventListenCommand represents 'kn event listen' command
+func NewEventListenCommand(p *commands.KnParams) *cobra.Command {
+	var flags listenFlags
+
+	eventListenCommand := &cobra.Command{
+		Use:   "listen",
+		Short: "Receive CloudEvents and print them.",
+		Example: `
+  # Print the events received on port 8080
+  kn event listen --port 8080
+
+  # Print each received event as a line of JSON, stopping after 10 events
+  kn event listen --output json --count 10
+
+  # Reply to each event with an event of type 'dev.knative.reply'
+  kn event listen --reply-type dev.knative.reply --reply-source /kn/listen --reply-data '{"ok": true}'`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if flags.Output != "" && flags.Output != "json" {
+				return fmt.Errorf("invalid output format '%s', only 'json' is supported", flags.Output)
+			}
+			l := &listener{out: cmd.OutOrStdout(), errOut: cmd.OutOrStderr(), json: flags.Output == "json", done: make(chan struct{})}
+			if flags.Count > 0 {
+				l.limited = true
+				l.remaining = flags.Count
+			}
+			if flags.ReplyType != "" || flags.ReplySource != "" {
+				var err error
+				l.reply, err = flags.replyTemplate()
+				if err != nil {
+					return err
+				}
+			}
+
+			socket, err := net.Listen("tcp", ":"+strconv.Itoa(flags.Port))
+			if err != nil {
+				return err
+			}
+			server := &http.Server{Handler: l}
+			errs := make(chan error, 1)
+			go func() {
+				errs <- server.Serve(socket)
+			}()
+			fmt.Fprintf(cmd.OutOrStderr(), "Listening for CloudEvents on port %d.\n", socket.Addr().(*net.TCPAddr).Port)
+			select {
+			case err = <-errs:
+				return err
+			case <-l.done:
+				// let the response to the last event be flushed before exiting
+				ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+				defer cancel()
+				return server.Shutdown(ctx)
+			}
+		},
+	}
+	eventListenCommand.Flags().IntVar(&flags.Port, "port", 8080, "Port to listen on.")
+	eventListenCommand.Flags().StringVarP(&flags.Output, "output", "o", "", "Output format. Use 'json' to print each event as a line of JSON.")
+	eventListenCommand.Flags().IntVar(&flags.Count, "count", 0, "Number of events to receive before exiting. Events are received until interrupted if not given.")
+	eventListenCommand.Flags().StringVar(&flags.ReplyType, "reply-type", "", "Type of the event to reply with. No reply is sent if not given.")
+	eventListenCommand.Flags().StringVar(&flags.ReplySource, "reply-source", "", "Source of the event to reply with.")
+	eventListenCommand.Flags().StringVar(&flags.ReplyData, "reply-data", "", "Data of the event to reply with. Use @FILE to read the data from a file.")
+	eventListenCommand.Flags().StringVar(&flags.ReplyContentType, "reply-content-type", "application/json", "Content type of the reply data.")
+	return eventListenCommand
+}
+
+// replyTemplate creates the event replied with, which gets a new ID for
+// each reply
+func (f *listenFlags) replyTemplate() (*eventing.CloudEvent, error) {
+	reply, err := eventing.NewCloudEvent(f.ReplyType, f.ReplySource)
+	if err != nil {
+		return nil, err
+	}
+	if f.ReplyData != "" {
+		reply.Data, err = commands.ReadData(f.ReplyData)
+		if err != nil {
+			return nil, err
+		}
+		reply.DataContentType = f.ReplyContentType
+	}
+	if err = reply.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid reply: %v", err)
+	}
+	return reply, nil
+}
+
+// listener is an HTTP handler printing the received events and optionally
+// replying to them. Requests which are ignored are reported on errOut, so
+// that out only contains events.
+type listener struct {
+	out    io.Writer
+	errOut io.Writer
+	json   bool
+	reply  *eventing.CloudEvent
+
+	// Whether done is closed after receiving the remaining number of
+	// events. Requests received after that are rejected.
+	limited   bool
+	remaining int
+	done      chan struct{}
+
+	lock sync.Mutex
+}
+
+func (l *listener) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	event, err := eventing.DecodeCloudEvent(r.Header, body)
+	if err == nil && event == nil {
+		err = errors.New("no CloudEvent in request")
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if l.limited && l.remaining == 0 {
+		http.Error(w, "all events to wait for have been received", http.StatusServiceUnavailable)
+		return
+	}
+	if err != nil {
+		fmt.Fprintf(l.errOut, "Ignoring request from %s: %v\n", r.RemoteAddr, err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if l.json {
+		err = printEventJSON(l.out, event)
+	} else {
+		err = printEvent(l.out, event)
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if l.reply != nil {
+		reply := *l.reply
+		reply.ID, err = eventing.NewEventID()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		body := reply.EncodeBinary(w.Header())
+		w.WriteHeader(http.StatusOK)
+		w.Write(body)
+	} else {
+		w.WriteHeader(http.StatusAccepted)
+	}
+
+	if l.limited {
+		l.remaining--
+		if l.remaining == 0 {
+			close(l.done)
+		}
+	}
+}
+
+// printEvent prints the attributes and the data of an event, indenting
+// JSON data
+func printEvent(out io.Writer, event *eventing.CloudEvent) error {
+	fmt.Fprintln(out, "Event received:")
+	w := hprinters.GetNewTabWriter(out)
+	attribute := func(name, value string) {
+		if value != "" {
+			fmt.Fprintf(w, "  %s:\t%s\n", name, value)
+		}
+	}
+	attribute("specversion", event.SpecVersion)
+	attribute("type", event.Type)
+	attribute("source", event.Source)
+	attribute("id", event.ID)
+	attribute("time", event.Time)
+	attribute("datacontenttype", event.DataContentType)
+	for _, name := range event.ExtensionNames() {
+		attribute(name, event.Extensions[name])
+	}
+	err := w.Flush()
+	if err != nil {
+		return err
+	}
+
+	if len(event.Data) > 0 {
+		fmt.Fprintln(out, "Data:")
+		var indented bytes.Buffer
+		if json.Indent(&indented, event.Data, "  ", "  ") == nil {
+			fmt.Fprintf(out, "  %s\n", indented.String())
+		} else {
+			fmt.Fprintf(out, "  %s\n", event.Data)
+		}
+	}
+	fmt.Fprintln(out)
+	return nil
+}
+
+// printEventJSON prints an event in its structured form as a single line
+// of JSON
+func printEventJSON(out io.Writer, event *eventing.CloudEvent) error {
+	body, err := event.EncodeStructured(http.Header{})
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "%s\n", body)
+	return nil
+}
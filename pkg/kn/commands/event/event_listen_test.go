@@ -0,0 +1,213 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package event
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/knative/client/pkg/eventing"
+	"github.com/knative/client/pkg/kn/commands"
+)
+
+func newTestEvent(t *testing.T, data string) *eventing.CloudEvent {
+	event, err := eventing.NewCloudEvent("dev.knative.foo", "/kn/test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	event.ID = "1234"
+	event.Extensions["myext"] = "bar"
+	event.Data = []byte(data)
+	event.DataContentType = "application/json"
+	return event
+}
+
+func postEvent(t *testing.T, url string, event *eventing.CloudEvent, structured bool) *http.Response {
+	req, err := eventing.NewCloudEventRequest(url, event, structured)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp
+}
+
+func TestListenerPrint(t *testing.T) {
+	out := &bytes.Buffer{}
+	server := httptest.NewServer(&listener{out: out})
+	defer server.Close()
+
+	for _, structured := range []bool{false, true} {
+		out.Reset()
+		resp := postEvent(t, server.URL, newTestEvent(t, `{"a":1}`), structured)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusAccepted {
+			t.Errorf("unexpected status %s", resp.Status)
+		}
+		expected := []string{
+			"Event received:",
+			"  specversion:", "0.3",
+			"  type:", "dev.knative.foo",
+			"  source:", "/kn/test",
+			"  id:", "1234",
+			"  myext:", "bar",
+			"Data:\n  {\n    \"a\": 1\n  }",
+		}
+		for _, each := range expected {
+			if !strings.Contains(out.String(), each) {
+				t.Errorf("structured %v: missing %s in output:\n%s", structured, each, out.String())
+			}
+		}
+	}
+}
+
+func TestListenerJSON(t *testing.T) {
+	out := &bytes.Buffer{}
+	server := httptest.NewServer(&listener{out: out, json: true})
+	defer server.Close()
+
+	resp := postEvent(t, server.URL, newTestEvent(t, `{"a":1}`), false)
+	resp.Body.Close()
+	resp = postEvent(t, server.URL, newTestEvent(t, `{"a":2}`), true)
+	resp.Body.Close()
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines of JSON, got:\n%s", out.String())
+	}
+	for i, line := range lines {
+		var content map[string]interface{}
+		err := json.Unmarshal([]byte(line), &content)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if content["id"] != "1234" || content["myext"] != "bar" || content["data"].(map[string]interface{})["a"] != float64(i+1) {
+			t.Errorf("unexpected event %v", content)
+		}
+	}
+}
+
+func TestListenerReply(t *testing.T) {
+	flags := listenFlags{ReplyType: "dev.knative.reply", ReplySource: "/kn/listen", ReplyData: `{"ok":true}`, ReplyContentType: "application/json"}
+	reply, err := flags.replyTemplate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(&listener{out: &bytes.Buffer{}, reply: reply})
+	defer server.Close()
+
+	var ids []string
+	for i := 0; i < 2; i++ {
+		resp := postEvent(t, server.URL, newTestEvent(t, ""), false)
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("unexpected status %s", resp.Status)
+		}
+		event, err := eventing.DecodeCloudEvent(resp.Header, body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if event == nil || event.Type != "dev.knative.reply" || event.Source != "/kn/listen" || string(event.Data) != `{"ok":true}` {
+			t.Fatalf("unexpected reply %v", event)
+		}
+		ids = append(ids, event.ID)
+	}
+	if ids[0] == ids[1] {
+		t.Errorf("expected a new ID for each reply, got %v", ids)
+	}
+}
+
+func TestListenerNoEvent(t *testing.T) {
+	out, errOut := &bytes.Buffer{}, &bytes.Buffer{}
+	server := httptest.NewServer(&listener{out: out, errOut: errOut, json: true})
+	defer server.Close()
+
+	resp, err := http.Post(server.URL, "text/plain", strings.NewReader("hello"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("unexpected status %s", resp.Status)
+	}
+	if !strings.Contains(errOut.String(), "no CloudEvent in request") {
+		t.Errorf("unexpected error output: %s", errOut.String())
+	}
+	if out.Len() != 0 {
+		t.Errorf("unexpected output: %s", out.String())
+	}
+}
+
+func TestListenerCount(t *testing.T) {
+	out := &bytes.Buffer{}
+	l := &listener{out: out, limited: true, remaining: 2, done: make(chan struct{})}
+	server := httptest.NewServer(l)
+	defer server.Close()
+
+	for i := 0; i < 2; i++ {
+		select {
+		case <-l.done:
+			t.Fatalf("done after %d events", i)
+		default:
+		}
+		resp := postEvent(t, server.URL, newTestEvent(t, ""), false)
+		resp.Body.Close()
+	}
+	select {
+	case <-l.done:
+	default:
+		t.Error("expected to be done after 2 events")
+	}
+
+	printed := out.String()
+	resp := postEvent(t, server.URL, newTestEvent(t, ""), false)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("expected status %d after the last event, got %d", http.StatusServiceUnavailable, resp.StatusCode)
+	}
+	if out.String() != printed {
+		t.Errorf("event printed after the last event:\n%s", strings.TrimPrefix(out.String(), printed))
+	}
+}
+
+func TestEventListenErrors(t *testing.T) {
+	for _, tc := range []struct {
+		args     []string
+		expected string
+	}{
+		{[]string{"event", "listen", "-o", "yaml"}, "invalid output format 'yaml'"},
+		{[]string{"event", "listen", "--reply-type", "dev.knative.reply"}, "invalid reply: invalid event, missing attributes: source"},
+		{[]string{"event", "listen", "--port", "-1"}, "invalid port"},
+	} {
+		knParams := &commands.KnParams{}
+		cmd, _, _ := commands.CreateTestKnCommand(NewEventCommand(knParams), knParams)
+		cmd.SetArgs(tc.args)
+		err := cmd.Execute()
+		if err == nil || !strings.Contains(err.Error(), tc.expected) {
+			t.Errorf("expected error '%s' for %v, got %v", tc.expected, tc.args, err)
+		}
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package event
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/knative/client/pkg/eventing"
+	"github.com/knative/client/pkg/kn/commands"
+	servinglib "github.com/knative/client/pkg/serving"
+	"github.com/spf13/cobra"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type sendFlags struct {
+	To          string
+	Ingress     string
+	Type        string
+	Source      string
+	ID          string
+	Data        string
+	ContentType string
+	Extensions  []string
+	Mode        string
+}
+
+// Modes of sending events
+const (
+	modeBinary     = "binary"
+	modeStructured = "structured"
+)
+
+// NewEventSendCommand represents 'kn event send' command
+func NewEventSendCommand(p *commands.KnParams) *cobra.Command {
+	var flags sendFlags
+
+	eventSendCommand := &cobra.Command{
+		Use:   "send --to TARGET --type TYPE --source SOURCE",
+		Short: "Send a CloudEvent.",
+		Example: `
+  # Send an event with the content of 'event.json' as data to the service 'svc1'
+  kn event send --to svc:svc1 --type dev.knative.foo --source /kn/test --data @event.json
+
+  # Send an event in structured mode to a URL
+  kn event send --to http://localhost:8080 --type dev.knative.foo --source /kn/test --mode structured`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if flags.To == "" {
+				return errors.New("requires the target to send the event to.")
+			}
+			if flags.Mode != modeBinary && flags.Mode != modeStructured {
+				return fmt.Errorf("invalid mode '%s', expected '%s' or '%s'", flags.Mode, modeBinary, modeStructured)
+			}
+			event, err := flags.newEvent()
+			if err != nil {
+				return err
+			}
+
+			url, host := flags.To, ""
+			if strings.HasPrefix(flags.To, "svc:") {
+				url, host, err = resolveService(p, cmd, strings.TrimPrefix(flags.To, "svc:"), flags.Ingress)
+				if err != nil {
+					return err
+				}
+			} else if !strings.HasPrefix(url, "http://") && !strings.HasPrefix(url, "https://") {
+				return fmt.Errorf("invalid target '%s', expected 'svc:NAME' or an http(s) URL", flags.To)
+			}
+
+			req, err := eventing.NewCloudEventRequest(url, event, flags.Mode == modeStructured)
+			if err != nil {
+				return err
+			}
+			if host != "" {
+				req.Host = host
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				return err
+			}
+			defer resp.Body.Close()
+			fmt.Fprintf(cmd.OutOrStdout(), "Event '%s' sent to '%s', response status: %s\n", event.ID, flags.To, resp.Status)
+			if resp.StatusCode >= http.StatusBadRequest {
+				return fmt.Errorf("sending event failed with status '%s'", resp.Status)
+			}
+			return nil
+		},
+	}
+	commands.AddNamespaceFlags(eventSendCommand.Flags(), false)
+	flags.AddFlags(eventSendCommand)
+	return eventSendCommand
+}
+
+func (f *sendFlags) AddFlags(command *cobra.Command) {
+	command.Flags().StringVar(&f.To, "to", "", "Target of the event, either 'svc:NAME' for a Knative service or an http(s) URL.")
+	command.Flags().StringVar(&f.Ingress, "ingress", "", "Address (HOST[:PORT]) or URL of the ingress to send events for services to. Looked up from the cluster if not given.")
+	command.Flags().StringVar(&f.Type, "type", "", "Type of the event.")
+	command.Flags().StringVar(&f.Source, "source", "", "Source of the event.")
+	command.Flags().StringVar(&f.ID, "id", "", "ID of the event. A random ID is used if not given.")
+	command.Flags().StringVarP(&f.Data, "data", "d", "", "Data of the event. Use @FILE to read the data from a file.")
+	command.Flags().StringVar(&f.ContentType, "content-type", "application/json", "Content type of the data.")
+	command.Flags().StringArrayVarP(&f.Extensions, "extension", "e", []string{},
+		"Extension attribute to set. NAME=value; you may provide this flag "+
+			"any number of times to set multiple extensions.")
+	command.Flags().StringVar(&f.Mode, "mode", modeBinary, "Mode of sending the event, either 'binary' with the attributes as headers or 'structured' with the whole event as JSON.")
+}
+
+// newEvent creates the event described by the flags
+func (f *sendFlags) newEvent() (*eventing.CloudEvent, error) {
+	event, err := eventing.NewCloudEvent(f.Type, f.Source)
+	if err != nil {
+		return nil, err
+	}
+	if f.ID != "" {
+		event.ID = f.ID
+	}
+	for _, pairStr := range f.Extensions {
+		name, value, err := commands.SplitKeyValue("extension", pairStr)
+		if err != nil {
+			return nil, err
+		}
+		event.Extensions[strings.ToLower(name)] = value
+	}
+	if f.Data != "" {
+		event.Data, err = commands.ReadData(f.Data)
+		if err != nil {
+			return nil, err
+		}
+		event.DataContentType = f.ContentType
+	}
+	return event, event.Validate()
+}
+
+// resolveService returns the URL of the ingress and the host to route a
+// request to the given service
+func resolveService(p *commands.KnParams, cmd *cobra.Command, name, ingress string) (string, string, error) {
+	namespace, err := commands.GetNamespace(cmd)
+	if err != nil {
+		return "", "", err
+	}
+	client, err := p.ServingFactory()
+	if err != nil {
+		return "", "", err
+	}
+	service, err := client.Services(namespace).Get(name, v1.GetOptions{})
+	if err != nil {
+		return "", "", err
+	}
+	coreClient, err := p.CoreFactory()
+	if err != nil {
+		return "", "", err
+	}
+	url, host, err := servinglib.GetServiceRequestTarget(coreClient, service, ingress)
+	if err != nil {
+		return "", "", err
+	}
+	return url + "/", host, nil
+}
@@ -0,0 +1,166 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package event
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/knative/client/pkg/eventing"
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/knative/serving/pkg/apis/serving/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	client_testing "k8s.io/client-go/testing"
+)
+
+type receivedEvent struct {
+	host  string
+	event *eventing.CloudEvent
+}
+
+func newReceiver(t *testing.T, status int, received *receivedEvent) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		event, err := eventing.DecodeCloudEvent(r.Header, body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		*received = receivedEvent{r.Host, event}
+		w.WriteHeader(status)
+	}))
+}
+
+func fakeEventSend(args []string, service *v1alpha1.Service, gateway *corev1.Service) (output string, err error) {
+	knParams := &commands.KnParams{}
+	cmd, fakeServing, fakeCore, buf := commands.CreateTestKnCommandWithCore(NewEventCommand(knParams), knParams)
+	fakeServing.AddReactor("get", "services",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			return true, service, nil
+		})
+	fakeCore.AddReactor("get", "services",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			return true, gateway, nil
+		})
+	cmd.SetArgs(args)
+	err = cmd.Execute()
+	output = buf.String()
+	return
+}
+
+func TestEventSendURL(t *testing.T) {
+	var received receivedEvent
+	server := newReceiver(t, http.StatusAccepted, &received)
+	defer server.Close()
+
+	output, err := fakeEventSend([]string{"event", "send", "--to", server.URL,
+		"--type", "dev.knative.foo", "--source", "/kn/test", "--id", "1234", "-d", `{"a":1}`, "-e", "myext=bar"}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(output, "Event '1234' sent to '"+server.URL+"', response status: 202 Accepted") {
+		t.Errorf("unexpected output: %s", output)
+	}
+	event := received.event
+	if event == nil || event.ID != "1234" || event.Type != "dev.knative.foo" || event.Source != "/kn/test" ||
+		event.SpecVersion != eventing.CloudEventsSpecVersion || event.Extensions["myext"] != "bar" {
+		t.Fatalf("unexpected event %v", event)
+	}
+	if event.DataContentType != "application/json" || string(event.Data) != `{"a":1}` {
+		t.Errorf("unexpected data %s of type %s", event.Data, event.DataContentType)
+	}
+}
+
+func TestEventSendStructuredFromFile(t *testing.T) {
+	var received receivedEvent
+	server := newReceiver(t, http.StatusOK, &received)
+	defer server.Close()
+
+	tempDir, err := ioutil.TempDir("", "kn-event")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+	file := filepath.Join(tempDir, "data.txt")
+	err = ioutil.WriteFile(file, []byte("hello"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = fakeEventSend([]string{"event", "send", "--to", server.URL, "--mode", "structured",
+		"--type", "dev.knative.foo", "--source", "/kn/test", "--content-type", "text/plain", "--data", "@" + file}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event := received.event; event == nil || string(event.Data) != "hello" || event.DataContentType != "text/plain" || event.ID == "" {
+		t.Errorf("unexpected event %v", event)
+	}
+}
+
+func TestEventSendService(t *testing.T) {
+	var received receivedEvent
+	server := newReceiver(t, http.StatusAccepted, &received)
+	defer server.Close()
+
+	service := &v1alpha1.Service{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"}}
+	service.Status.DeprecatedDomain = "foo.default.example.com"
+	gateway := &corev1.Service{}
+	gateway.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{{IP: strings.TrimPrefix(server.URL, "http://")}}
+	_, err := fakeEventSend([]string{"event", "send", "--to", "svc:foo", "--type", "dev.knative.foo", "--source", "/kn/test"}, service, gateway)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if received.host != "foo.default.example.com" || received.event == nil {
+		t.Errorf("unexpected request for %s with event %v", received.host, received.event)
+	}
+}
+
+func TestEventSendFailure(t *testing.T) {
+	var received receivedEvent
+	server := newReceiver(t, http.StatusBadRequest, &received)
+	defer server.Close()
+
+	_, err := fakeEventSend([]string{"event", "send", "--to", server.URL, "--type", "dev.knative.foo", "--source", "/kn/test"}, nil, nil)
+	if err == nil || err.Error() != "sending event failed with status '400 Bad Request'" {
+		t.Errorf("expected error for failed request, got %v", err)
+	}
+}
+
+func TestEventSendErrors(t *testing.T) {
+	for _, tc := range []struct {
+		args     []string
+		expected string
+	}{
+		{[]string{"event", "send", "--type", "t", "--source", "s"}, "requires the target to send the event to."},
+		{[]string{"event", "send", "--to", "foo", "--type", "t", "--source", "s"}, "invalid target 'foo'"},
+		{[]string{"event", "send", "--to", "http://localhost", "--source", "s"}, "missing attributes: type"},
+		{[]string{"event", "send", "--to", "http://localhost", "--type", "t", "--source", "s", "--mode", "batch"}, "invalid mode 'batch'"},
+		{[]string{"event", "send", "--to", "http://localhost", "--type", "t", "--source", "s", "-e", "foo"}, "requires a value that contains the '=' character"},
+	} {
+		_, err := fakeEventSend(tc.args, nil, nil)
+		if err == nil || !strings.Contains(err.Error(), tc.expected) {
+			t.Errorf("expected error '%s' for %v, got %v", tc.expected, tc.args, err)
+		}
+	}
+}
@@ -16,6 +16,7 @@ package commands
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	hprinters "github.com/knative/client/pkg/printers"
@@ -30,27 +31,64 @@ import (
 // Given the following flag values, a printer can be requested that knows
 // how to handle printing based on these values.
 type HumanPrintFlags struct {
-	//TODO: Add more flags as required
+	NoHeaders    bool
+	SortBy       string
+	ShowLabels   bool
+	ColumnLabels []string
 }
 
 // AllowedFormats returns more customized formating options
 func (f *HumanPrintFlags) AllowedFormats() []string {
-	// TODO: Add more formats eg: wide
-	return []string{""}
+	return []string{"wide"}
+}
+
+// IsHumanReadableFormat returns whether the given output format is
+// printed as a table rather than by the generic printers
+func (f *HumanPrintFlags) IsHumanReadableFormat(outputFormat string) bool {
+	if outputFormat == "" {
+		return true
+	}
+	for _, format := range f.AllowedFormats() {
+		if format == outputFormat {
+			return true
+		}
+	}
+	return false
 }
 
 // ToPrinter receives returns a printer capable of
-// handling human-readable output.
-func (f *HumanPrintFlags) ToPrinter(getHandlerFunc func(h hprinters.PrintHandler)) (hprinters.ResourcePrinter, error) {
-	p := hprinters.NewTablePrinter(hprinters.PrintOptions{})
+// handling human-readable output in the given format.
+func (f *HumanPrintFlags) ToPrinter(outputFormat string, getHandlerFunc func(h hprinters.PrintHandler)) (hprinters.ResourcePrinter, error) {
+	if !f.IsHumanReadableFormat(outputFormat) {
+		return nil, fmt.Errorf("unable to match a printer suitable for the output format \"%s\"", outputFormat)
+	}
+	if f.SortBy != "" {
+		if _, err := hprinters.ParseSortBy(f.SortBy); err != nil {
+			return nil, err
+		}
+	}
+	p := hprinters.NewTablePrinter(hprinters.PrintOptions{
+		Wide:         outputFormat == "wide",
+		NoHeaders:    f.NoHeaders,
+		SortBy:       f.SortBy,
+		ShowLabels:   f.ShowLabels,
+		ColumnLabels: f.ColumnLabels,
+	})
 	getHandlerFunc(p)
 	return p, nil
 }
 
 // AddFlags receives a *cobra.Command reference and binds
-// flags related to human-readable printing to it
+// flags related to human-readable printing to it. The formats of the
+// table printer are added to the usage of an already bound output flag.
 func (f *HumanPrintFlags) AddFlags(c *cobra.Command) {
-	//TODO: Add more flags as required
+	c.Flags().BoolVar(&f.NoHeaders, "no-headers", false, "When using the default or wide output format, don't print headers.")
+	c.Flags().StringVar(&f.SortBy, "sort-by", "", "Sort the table by the value of this JSONPath expression in the listed objects, e.g. '{.metadata.name}'.")
+	c.Flags().BoolVar(&f.ShowLabels, "show-labels", false, "When using the default or wide output format, show all labels as the last column.")
+	c.Flags().StringSliceVarP(&f.ColumnLabels, "label-columns", "L", nil, "Comma separated list of labels to be presented as columns. Can be specified multiple times.")
+	if output := c.Flags().Lookup("output"); output != nil {
+		output.Usage = fmt.Sprintf("%s|%s.", strings.TrimSuffix(output.Usage, "."), strings.Join(f.AllowedFormats(), "|"))
+	}
 }
 
 // NewHumanPrintFlags returns flags associated with
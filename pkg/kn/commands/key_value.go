@@ -0,0 +1,32 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SplitKeyValue splits a 'KEY=VALUE' argument of the given flag at the
+// first '='
+func SplitKeyValue(flag, pair string) (string, string, error) {
+	parts := strings.SplitN(pair, "=", 2)
+	if len(parts) <= 1 {
+		return "", "", fmt.Errorf(
+			"--%s argument requires a value that contains the '=' character; got %s",
+			flag, pair)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,45 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"testing"
+)
+
+func TestSplitKeyValue(t *testing.T) {
+	for _, tc := range []struct {
+		pair  string
+		key   string
+		value string
+	}{
+		{"a=b", "a", "b"},
+		{"a=", "a", ""},
+		{"a=b=c", "a", "b=c"},
+	} {
+		key, value, err := SplitKeyValue("env", tc.pair)
+		if err != nil {
+			t.Errorf("unexpected error for '%s': %v", tc.pair, err)
+		}
+		if key != tc.key || value != tc.value {
+			t.Errorf("expected '%s' and '%s' for '%s', got '%s' and '%s'", tc.key, tc.value, tc.pair, key, value)
+		}
+	}
+
+	_, _, err := SplitKeyValue("env", "a")
+	expected := "--env argument requires a value that contains the '=' character; got a"
+	if err == nil || err.Error() != expected {
+		t.Errorf("expected error '%s', got %v", expected, err)
+	}
+}
@@ -15,35 +15,53 @@
 package revision
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/knative/client/pkg/kn/commands"
 	hprinters "github.com/knative/client/pkg/printers"
+	servinglib "github.com/knative/client/pkg/serving"
 	serving "github.com/knative/serving/pkg/apis/serving"
 	servingv1alpha1 "github.com/knative/serving/pkg/apis/serving/v1alpha1"
 	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
-// RevisionGetHandlers adds print handlers for revision get command
-func RevisionGetHandlers(h hprinters.PrintHandler) {
-	RevisionColumnDefinitions := []metav1beta1.TableColumnDefinition{
-		{Name: "Service", Type: "string", Description: "Name of the knative service."},
-		{Name: "Name", Type: "string", Description: "Name of the revision."},
-		{Name: "Age", Type: "string", Description: "Age of the revision."},
-		{Name: "Conditions", Type: "string", Description: "Conditions describing statuses of revision."},
-		{Name: "Ready", Type: "string", Description: "Ready condition status of the revision."},
-		{Name: "Reason", Type: "string", Description: "Reason for non-ready condition of the revision."},
+// RevisionGetHandlers returns a function adding print handlers for revision
+// get command. The traffic of the revisions is looked up by
+// "namespace/name" in the given map.
+func RevisionGetHandlers(traffic map[string]*servinglib.RevisionTraffic) func(h hprinters.PrintHandler) {
+	return func(h hprinters.PrintHandler) {
+		RevisionColumnDefinitions := []metav1beta1.TableColumnDefinition{
+			{Name: "Service", Type: "string", Description: "Name of the knative service."},
+			{Name: "Name", Type: "string", Description: "Name of the revision."},
+			{Name: "Traffic", Type: "string", Description: "Percentage of traffic the revision receives."},
+			{Name: "Tags", Type: "string", Description: "Tags the revision is addressable by."},
+			{Name: "Age", Type: "string", Description: "Age of the revision."},
+			{Name: "Conditions", Type: "string", Description: "Conditions describing statuses of revision."},
+			{Name: "Ready", Type: "string", Description: "Ready condition status of the revision."},
+			{Name: "Reason", Type: "string", Description: "Reason for non-ready condition of the revision."},
+			{Name: "Image", Type: "string", Description: "Image of the revision.", Priority: 1},
+			{Name: "Concurrency", Type: "string", Description: "Concurrency limit and target of the revision.", Priority: 1},
+		}
+		printer := revisionPrinter{traffic: traffic}
+		h.TableHandler(RevisionColumnDefinitions, printer.printRevision)
+		h.TableHandler(RevisionColumnDefinitions, printer.printRevisionList)
 	}
-	h.TableHandler(RevisionColumnDefinitions, printRevision)
-	h.TableHandler(RevisionColumnDefinitions, printRevisionList)
 }
 
 // Private functions
 
+// revisionPrinter prints revisions together with their traffic
+type revisionPrinter struct {
+	traffic map[string]*servinglib.RevisionTraffic
+}
+
 // printRevisionList populates the knative revision list table rows
-func printRevisionList(revisionList *servingv1alpha1.RevisionList, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
+func (p revisionPrinter) printRevisionList(revisionList *servingv1alpha1.RevisionList, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
 	rows := make([]metav1beta1.TableRow, 0, len(revisionList.Items))
 	for _, rev := range revisionList.Items {
-		r, err := printRevision(&rev, options)
+		r, err := p.printRevision(&rev, options)
 		if err != nil {
 			return nil, err
 		}
@@ -53,22 +71,37 @@ func printRevisionList(revisionList *servingv1alpha1.RevisionList, options hprin
 }
 
 // printRevision populates the knative revision table rows
-func printRevision(revision *servingv1alpha1.Revision, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
+func (p revisionPrinter) printRevision(revision *servingv1alpha1.Revision, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
 	service := revision.Labels[serving.ConfigurationLabelKey]
 	name := revision.Name
+	traffic, tags := "", ""
+	if revisionTraffic := p.traffic[revision.Namespace+"/"+revision.Name]; revisionTraffic != nil {
+		traffic = fmt.Sprintf("%d%%", revisionTraffic.Percent)
+		tags = strings.Join(revisionTraffic.Tags, ",")
+	}
 	age := commands.TranslateTimestampSince(revision.CreationTimestamp)
 	conditions := commands.ConditionsValue(revision.Status.Conditions)
 	ready := commands.ReadyCondition(revision.Status.Conditions)
 	reason := commands.NonReadyConditionReason(revision.Status.Conditions)
+	image, concurrency := "", ""
+	if options.Wide {
+		template := &servingv1alpha1.RevisionTemplateSpec{ObjectMeta: revision.ObjectMeta, Spec: revision.Spec}
+		image, _ = servinglib.GetUserImage(template)
+		concurrency = servinglib.ConcurrencyValue(template)
+	}
 	row := metav1beta1.TableRow{
 		Object: runtime.RawExtension{Object: revision},
 	}
 	row.Cells = append(row.Cells,
 		service,
 		name,
+		traffic,
+		tags,
 		age,
 		conditions,
 		ready,
-		reason)
+		reason,
+		image,
+		concurrency)
 	return []metav1beta1.TableRow{row}, nil
 }
@@ -26,5 +26,7 @@ func NewRevisionCommand(p *commands.KnParams) *cobra.Command {
 	}
 	revisionCmd.AddCommand(NewRevisionGetCommand(p))
 	revisionCmd.AddCommand(NewRevisionDescribeCommand(p))
+	revisionCmd.AddCommand(NewRevisionDeleteCommand(p))
+	revisionCmd.AddCommand(NewRevisionDiffCommand(p))
 	return revisionCmd
 }
@@ -0,0 +1,143 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package revision
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/knative/client/pkg/kn/commands"
+	servinglib "github.com/knative/client/pkg/serving"
+	"github.com/knative/serving/pkg/apis/serving"
+	"github.com/spf13/cobra"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type revisionDeleteFlags struct {
+	Prune     bool
+	Service   string
+	Keep      int
+	OlderThan time.Duration
+	DryRun    bool
+}
+
+// NewRevisionDeleteCommand represents 'kn revision delete' command
+func NewRevisionDeleteCommand(p *commands.KnParams) *cobra.Command {
+	var flags revisionDeleteFlags
+
+	revisionDeleteCommand := &cobra.Command{
+		Use:   "delete NAME...",
+		Short: "Delete revisions.",
+		Example: `
+  # Delete revisions 'svc1-abcde' and 'svc1-fghij' in default namespace
+  kn revision delete svc1-abcde svc1-fghij
+
+  # Delete all revisions of service 'svc1' except the 5 newest ones and the ones in use
+  kn revision delete --prune --service svc1 --keep 5
+
+  # Show which revisions of service 'svc1' older than a week would be deleted
+  kn revision delete --prune --service svc1 --older-than 168h --dry-run`,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := flags.validate(args)
+			if err != nil {
+				return err
+			}
+			client, err := p.ServingFactory()
+			if err != nil {
+				return err
+			}
+			namespace, err := commands.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+
+			names := args
+			if flags.Prune {
+				service, err := client.Services(namespace).Get(flags.Service, v1.GetOptions{})
+				if err != nil {
+					return err
+				}
+				revisions, err := client.Revisions(namespace).List(v1.ListOptions{
+					LabelSelector: serving.ServiceLabelKey + "=" + flags.Service,
+				})
+				if err != nil {
+					return err
+				}
+				// standalone routes may target the service's revisions, too
+				routes, err := client.Routes(namespace).List(v1.ListOptions{})
+				if err != nil {
+					return err
+				}
+				names = nil
+				for _, revision := range servinglib.PruneCandidates(service, routes.Items, revisions.Items, flags.Keep, flags.OlderThan, time.Now()) {
+					names = append(names, revision.Name)
+				}
+				if len(names) == 0 {
+					fmt.Fprintf(cmd.OutOrStdout(), "No revisions of service '%s' to prune.\n", flags.Service)
+					return nil
+				}
+			}
+
+			for _, name := range names {
+				if flags.DryRun {
+					fmt.Fprintf(cmd.OutOrStdout(), "Revision '%s' would be deleted in namespace '%s' (dry run).\n", name, namespace)
+					continue
+				}
+				err = client.Revisions(namespace).Delete(name, &v1.DeleteOptions{})
+				if err != nil {
+					return err
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "Revision '%s' successfully deleted in namespace '%s'.\n", name, namespace)
+			}
+			return nil
+		},
+	}
+	commands.AddNamespaceFlags(revisionDeleteCommand.Flags(), false)
+	flags.AddFlags(revisionDeleteCommand)
+	return revisionDeleteCommand
+}
+
+func (f *revisionDeleteFlags) AddFlags(command *cobra.Command) {
+	command.Flags().BoolVar(&f.Prune, "prune", false, "Delete the revisions of the service given with --service which are not in use. "+
+		"Revisions receiving traffic from any route and the latest created and ready revisions are never deleted.")
+	command.Flags().StringVar(&f.Service, "service", "", "Service whose revisions to prune.")
+	command.Flags().IntVar(&f.Keep, "keep", 0, "Number of newest revisions to keep when pruning.")
+	command.Flags().DurationVar(&f.OlderThan, "older-than", 0, "Only prune revisions older than this duration (e.g. 72h).")
+	command.Flags().BoolVar(&f.DryRun, "dry-run", false, "Only print the revisions which would be deleted.")
+}
+
+func (f *revisionDeleteFlags) validate(args []string) error {
+	if !f.Prune {
+		if f.Service != "" || f.Keep != 0 || f.OlderThan != 0 {
+			return errors.New("--service, --keep and --older-than can only be used with --prune.")
+		}
+		if len(args) == 0 {
+			return errors.New("requires the revision name.")
+		}
+		return nil
+	}
+	if len(args) > 0 {
+		return errors.New("revision names can't be given with --prune.")
+	}
+	if f.Service == "" {
+		return errors.New("requires --service when pruning revisions.")
+	}
+	if f.Keep < 0 {
+		return errors.New("--keep must not be negative.")
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package revision
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/knative/serving/pkg/apis/serving"
+	"github.com/knative/serving/pkg/apis/serving/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	client_testing "k8s.io/client-go/testing"
+)
+
+func fakeRevisionDelete(args []string, service *v1alpha1.Service, revisions []v1alpha1.Revision, routes ...v1alpha1.Route) (deleted []string, output string, err error) {
+	knParams := &commands.KnParams{}
+	cmd, fakeServing, buf := commands.CreateTestKnCommand(NewRevisionCommand(knParams), knParams)
+	fakeServing.AddReactor("get", "services",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			return true, service, nil
+		})
+	fakeServing.AddReactor("list", "revisions",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			return true, &v1alpha1.RevisionList{Items: revisions}, nil
+		})
+	fakeServing.AddReactor("list", "routes",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			return true, &v1alpha1.RouteList{Items: routes}, nil
+		})
+	fakeServing.AddReactor("delete", "revisions",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			deleted = append(deleted, a.(client_testing.DeleteAction).GetName())
+			return true, nil, nil
+		})
+	cmd.SetArgs(args)
+	err = cmd.Execute()
+	output = buf.String()
+	return
+}
+
+func newServiceRevision(generation int, age time.Duration) v1alpha1.Revision {
+	return v1alpha1.Revision{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "foo-" + strconv.Itoa(generation),
+			Labels: map[string]string{
+				serving.ServiceLabelKey:                 "foo",
+				serving.ConfigurationGenerationLabelKey: strconv.Itoa(generation),
+			},
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-age)),
+		},
+	}
+}
+
+func newPruneTestService() *v1alpha1.Service {
+	service := &v1alpha1.Service{ObjectMeta: metav1.ObjectMeta{Name: "foo"}}
+	service.Status.LatestCreatedRevisionName = "foo-6"
+	service.Status.LatestReadyRevisionName = "foo-5"
+	service.Status.Traffic = []v1alpha1.TrafficTarget{{}}
+	service.Status.Traffic[0].RevisionName = "foo-2"
+	return service
+}
+
+func TestRevisionDelete(t *testing.T) {
+	deleted, output, err := fakeRevisionDelete([]string{"revision", "delete", "foo-1", "foo-2"}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(deleted, []string{"foo-1", "foo-2"}) {
+		t.Errorf("wrong revisions deleted: %v", deleted)
+	}
+	if !strings.Contains(output, "Revision 'foo-2' successfully deleted in namespace 'default'.") {
+		t.Errorf("unexpected output: %s", output)
+	}
+}
+
+func TestRevisionDeletePrune(t *testing.T) {
+	var revisions []v1alpha1.Revision
+	for generation := 1; generation <= 6; generation++ {
+		revisions = append(revisions, newServiceRevision(generation, time.Duration(7-generation)*24*time.Hour))
+	}
+	deleted, _, err := fakeRevisionDelete([]string{"revision", "delete", "--prune", "--service", "foo", "--keep", "1"},
+		newPruneTestService(), revisions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(deleted, []string{"foo-4", "foo-3", "foo-1"}) {
+		t.Errorf("wrong revisions deleted: %v", deleted)
+	}
+
+	deleted, _, err = fakeRevisionDelete([]string{"revision", "delete", "--prune", "--service", "foo", "--older-than", "100h"},
+		newPruneTestService(), revisions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(deleted, []string{"foo-1"}) {
+		t.Errorf("wrong revisions deleted: %v", deleted)
+	}
+}
+
+func TestRevisionDeletePruneStandaloneRoute(t *testing.T) {
+	var revisions []v1alpha1.Revision
+	for generation := 1; generation <= 6; generation++ {
+		revisions = append(revisions, newServiceRevision(generation, time.Duration(7-generation)*24*time.Hour))
+	}
+	route := v1alpha1.Route{ObjectMeta: metav1.ObjectMeta{Name: "pinned"}}
+	route.Spec.Traffic = []v1alpha1.TrafficTarget{{}}
+	route.Spec.Traffic[0].RevisionName = "foo-3"
+	route.Status.Traffic = []v1alpha1.TrafficTarget{{}}
+	route.Status.Traffic[0].RevisionName = "foo-1"
+
+	deleted, _, err := fakeRevisionDelete([]string{"revision", "delete", "--prune", "--service", "foo", "--keep", "1"},
+		newPruneTestService(), revisions, route)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(deleted, []string{"foo-4"}) {
+		t.Errorf("wrong revisions deleted: %v", deleted)
+	}
+}
+
+func TestRevisionDeletePruneDryRun(t *testing.T) {
+	revisions := []v1alpha1.Revision{newServiceRevision(1, time.Hour), newServiceRevision(5, 0)}
+	deleted, output, err := fakeRevisionDelete([]string{"revision", "delete", "--prune", "--service", "foo", "--dry-run"},
+		newPruneTestService(), revisions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deleted) != 0 {
+		t.Errorf("revisions deleted in dry run: %v", deleted)
+	}
+	if !strings.Contains(output, "Revision 'foo-1' would be deleted") || strings.Contains(output, "foo-5") {
+		t.Errorf("unexpected output: %s", output)
+	}
+
+	_, output, err = fakeRevisionDelete([]string{"revision", "delete", "--prune", "--service", "foo", "--keep", "1"},
+		newPruneTestService(), revisions[1:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(output, "No revisions of service 'foo' to prune.") {
+		t.Errorf("unexpected output: %s", output)
+	}
+}
+
+func TestRevisionDeleteInvalidFlags(t *testing.T) {
+	for _, args := range [][]string{
+		{"revision", "delete"},
+		{"revision", "delete", "foo-1", "--keep", "2"},
+		{"revision", "delete", "--prune"},
+		{"revision", "delete", "--prune", "--service", "foo", "foo-1"},
+		{"revision", "delete", "--prune", "--service", "foo", "--keep", "-1"},
+	} {
+		_, _, err := fakeRevisionDelete(args, newPruneTestService(), nil)
+		if err == nil {
+			t.Errorf("%v: expected an error", args)
+		}
+	}
+}
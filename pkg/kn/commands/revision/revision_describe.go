@@ -16,19 +16,34 @@ package revision
 
 import (
 	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
 
 	"github.com/knative/client/pkg/kn/commands"
+	hprinters "github.com/knative/client/pkg/printers"
+	servinglib "github.com/knative/client/pkg/serving"
+	"github.com/knative/serving/pkg/apis/autoscaling"
+	"github.com/knative/serving/pkg/apis/serving"
+	servingv1alpha1 "github.com/knative/serving/pkg/apis/serving/v1alpha1"
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 )
 
 func NewRevisionDescribeCommand(p *commands.KnParams) *cobra.Command {
-	revisionDescribePrintFlags := genericclioptions.NewPrintFlags("").WithDefaultOutput("yaml")
+	revisionDescribePrintFlags := genericclioptions.NewPrintFlags("")
 	revisionDescribeCmd := &cobra.Command{
 		Use:   "describe NAME",
 		Short: "Describe revisions.",
+		Example: `
+  # Describe revision 'svc1-abcde' in default namespace
+  kn revision describe svc1-abcde
+
+  # Print revision 'svc1-abcde' as YAML
+  kn revision describe svc1-abcde -o yaml`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) < 1 {
 				return errors.New("requires the revision name.")
@@ -48,22 +63,119 @@ func NewRevisionDescribeCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			printer, err := revisionDescribePrintFlags.ToPrinter()
-			if err != nil {
-				return err
+			if revisionDescribePrintFlags.OutputFlagSpecified() {
+				printer, err := revisionDescribePrintFlags.ToPrinter()
+				if err != nil {
+					return err
+				}
+				revision.GetObjectKind().SetGroupVersionKind(servingv1alpha1.SchemeGroupVersion.WithKind("Revision"))
+				return printer.PrintObj(revision, cmd.OutOrStdout())
 			}
-			revision.GetObjectKind().SetGroupVersionKind(schema.GroupVersionKind{
-				Group:   "knative.dev",
-				Version: "v1alpha1",
-				Kind:    "Revision"})
-			err = printer.PrintObj(revision, cmd.OutOrStdout())
+
+			routes, err := client.Routes(namespace).List(v1.ListOptions{})
 			if err != nil {
 				return err
 			}
-			return nil
+			return describeRevision(cmd.OutOrStdout(), revision, servinglib.GetRoutesTraffic(routes.Items, revision))
 		},
 	}
 	commands.AddNamespaceFlags(revisionDescribeCmd.Flags(), false)
 	revisionDescribePrintFlags.AddFlags(revisionDescribeCmd)
 	return revisionDescribeCmd
 }
+
+// describeRevision prints the settings of the revision's container, its
+// owners, its traffic and its conditions
+func describeRevision(out io.Writer, revision *servingv1alpha1.Revision, traffic []servinglib.RouteTraffic) error {
+	container, err := servinglib.GetRevisionContainer(revision)
+	if err != nil {
+		return err
+	}
+
+	w := hprinters.GetNewTabWriter(out)
+	field := commands.FieldPrinter(w)
+	field("Name", revision.Name)
+	field("Namespace", revision.Namespace)
+	field("Age", commands.TranslateTimestampSince(revision.CreationTimestamp))
+	field("Service", revision.Labels[serving.ServiceLabelKey])
+	field("Configuration", revision.Labels[serving.ConfigurationLabelKey])
+	field("Generation", revision.Labels[serving.ConfigurationGenerationLabelKey])
+
+	image := container.Image
+	if userImage := revision.Annotations[servinglib.UserImageAnnotationKey]; userImage != "" && userImage != image {
+		image = fmt.Sprintf("%s (locked from %s)", image, userImage)
+	}
+	field("Image", image)
+	field("Digest", revision.Status.ImageDigest)
+	field("Env", formatEnv(container.Env))
+	field("Requests", formatResources(container.Resources.Requests))
+	field("Limits", formatResources(container.Resources.Limits))
+
+	field("Concurrency", servinglib.ConcurrencyValue(&servingv1alpha1.RevisionTemplateSpec{
+		ObjectMeta: revision.ObjectMeta,
+		Spec:       revision.Spec,
+	}))
+
+	var scale []string
+	if minScale := revision.Annotations[autoscaling.MinScaleAnnotationKey]; minScale != "" {
+		scale = append(scale, "min "+minScale)
+	}
+	if maxScale := revision.Annotations[autoscaling.MaxScaleAnnotationKey]; maxScale != "" {
+		scale = append(scale, "max "+maxScale)
+	}
+	if class := revision.Annotations[autoscaling.ClassAnnotationKey]; class != "" {
+		scale = append(scale, "class "+class)
+	}
+	field("Scale", strings.Join(scale, ", "))
+
+	// the traffic is listed per route, as the shares of routes can't be added up
+	label := "Traffic:"
+	for _, routeTraffic := range traffic {
+		fmt.Fprintf(w, "%s\t%s\n", label, formatRouteTraffic(routeTraffic))
+		label = ""
+	}
+	err = w.Flush()
+	if err != nil {
+		return err
+	}
+
+	return commands.PrintConditions(out, revision.Status.Conditions)
+}
+
+func formatRouteTraffic(traffic servinglib.RouteTraffic) string {
+	value := fmt.Sprintf("%s: %d%%", traffic.Route, traffic.Percent)
+	switch len(traffic.Tags) {
+	case 0:
+		return value
+	case 1:
+		return fmt.Sprintf("%s (tag %s)", value, traffic.Tags[0])
+	default:
+		return fmt.Sprintf("%s (tags %s)", value, strings.Join(traffic.Tags, ", "))
+	}
+}
+
+func formatEnv(env []corev1.EnvVar) string {
+	vars := make([]string, 0, len(env))
+	for _, envVar := range env {
+		value := envVar.Value
+		if envVar.ValueFrom != nil {
+			value = "<from reference>"
+		}
+		vars = append(vars, envVar.Name+"="+value)
+	}
+	return strings.Join(vars, ", ")
+}
+
+func formatResources(resources corev1.ResourceList) string {
+	names := make([]string, 0, len(resources))
+	for name := range resources {
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+	values := make([]string, 0, len(names))
+	for _, name := range names {
+		quantity := resources[corev1.ResourceName(name)]
+		values = append(values, name+"="+quantity.String())
+	}
+	return strings.Join(values, ", ")
+}
@@ -16,12 +16,19 @@ package revision
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/knative/client/pkg/kn/commands"
+	servinglib "github.com/knative/client/pkg/serving"
+	"github.com/knative/pkg/apis"
+	duckv1beta1 "github.com/knative/pkg/apis/duck/v1beta1"
+	"github.com/knative/serving/pkg/apis/autoscaling"
+	"github.com/knative/serving/pkg/apis/serving"
 	"github.com/knative/serving/pkg/apis/serving/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	client_testing "k8s.io/client-go/testing"
@@ -29,13 +36,21 @@ import (
 )
 
 func fakeRevision(args []string, response *v1alpha1.Revision) (action client_testing.Action, output string, err error) {
+	return fakeRevisionWithRoutes(args, response, &v1alpha1.RouteList{})
+}
+
+func fakeRevisionWithRoutes(args []string, response *v1alpha1.Revision, routes *v1alpha1.RouteList) (action client_testing.Action, output string, err error) {
 	knParams := &commands.KnParams{}
 	cmd, fakeServing, buf := commands.CreateTestKnCommand(NewRevisionCommand(knParams), knParams)
-	fakeServing.AddReactor("*", "*",
+	fakeServing.AddReactor("get", "revisions",
 		func(a client_testing.Action) (bool, runtime.Object, error) {
 			action = a
 			return true, response, nil
 		})
+	fakeServing.AddReactor("list", "routes",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			return true, routes, nil
+		})
 	cmd.SetArgs(args)
 	err = cmd.Execute()
 	if err != nil {
@@ -70,7 +85,7 @@ func TestDescribeRevisionYaml(t *testing.T) {
 		},
 	}
 
-	action, data, err := fakeRevision([]string{"revision", "describe", "test-rev"}, &expectedRevision)
+	action, data, err := fakeRevision([]string{"revision", "describe", "test-rev", "-o", "yaml"}, &expectedRevision)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -95,4 +110,88 @@ func TestDescribeRevisionYaml(t *testing.T) {
 	if !equality.Semantic.DeepEqual(expectedRevision, returnedRevision) {
 		t.Fatal("mismatched objects")
 	}
+	if returnedRevision.APIVersion != "serving.knative.dev/v1alpha1" || returnedRevision.Kind != "Revision" {
+		t.Fatalf("wrong type %s %s", returnedRevision.APIVersion, returnedRevision.Kind)
+	}
+}
+
+func TestDescribeRevisionHumanReadable(t *testing.T) {
+	revision := v1alpha1.Revision{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "foo-abcde",
+			Namespace: "default",
+			Labels: map[string]string{
+				serving.ServiceLabelKey:                 "foo",
+				serving.ConfigurationLabelKey:           "foo",
+				serving.ConfigurationGenerationLabelKey: "2",
+			},
+			Annotations: map[string]string{
+				servinglib.UserImageAnnotationKey: "knative/test:latest",
+				autoscaling.MinScaleAnnotationKey: "1",
+				autoscaling.TargetAnnotationKey:   "5",
+			},
+		},
+		Spec: v1alpha1.RevisionSpec{
+			DeprecatedContainer: &corev1.Container{
+				Image: "knative/test@sha256:deadbeef",
+				Env:   []corev1.EnvVar{{Name: "FOO", Value: "bar"}},
+				Resources: corev1.ResourceRequirements{
+					Limits: corev1.ResourceList{
+						corev1.ResourceMemory: resource.MustParse("64Mi"),
+						corev1.ResourceCPU:    resource.MustParse("250m"),
+					},
+				},
+			},
+		},
+	}
+	revision.Spec.ContainerConcurrency = 10
+	revision.Status.ImageDigest = "index.docker.io/knative/test@sha256:deadbeef"
+	revision.Status.Conditions = duckv1beta1.Conditions{
+		{Type: apis.ConditionReady, Status: corev1.ConditionFalse, Reason: "ContainerMissing", Message: "image not found"},
+	}
+
+	// the traffic of the service's route and of a standalone route is listed per route
+	routes := &v1alpha1.RouteList{}
+	for _, target := range []struct {
+		route   string
+		percent int
+	}{{"foo", 30}, {"pinned", 20}} {
+		route := v1alpha1.Route{ObjectMeta: metav1.ObjectMeta{Name: target.route, Namespace: "default"}}
+		route.Status.Traffic = make([]v1alpha1.TrafficTarget, 1)
+		route.Status.Traffic[0].RevisionName = "foo-abcde"
+		route.Status.Traffic[0].Percent = target.percent
+		route.Status.Traffic[0].Tag = "candidate"
+		routes.Items = append(routes.Items, route)
+	}
+	// routes of other namespaces don't target the revision
+	other := routes.Items[0].DeepCopy()
+	other.Namespace = "other"
+	routes.Items = append(routes.Items, *other)
+
+	_, output, err := fakeRevisionWithRoutes([]string{"revision", "describe", "foo-abcde"}, &revision, routes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		"Name:", "foo-abcde",
+		"Service:", "Configuration:", "Generation:",
+		"Image:", "knative/test@sha256:deadbeef (locked from knative/test:latest)",
+		"Digest:", "index.docker.io/knative/test@sha256:deadbeef",
+		"Env:", "FOO=bar",
+		"Limits:", "cpu=250m, memory=64Mi",
+		"Concurrency:", "limit 10, target 5",
+		"Scale:", "min 1",
+		"Traffic:", "foo: 30% (tag candidate)", "pinned: 20% (tag candidate)",
+		"Conditions:", "Ready", "False", "ContainerMissing : image not found",
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("missing '%s' in output:\n%s", expected, output)
+		}
+	}
+	if strings.Count(output, "foo: 30%") != 1 {
+		t.Errorf("expected the traffic of route 'foo' of namespace 'default' only:\n%s", output)
+	}
+	if strings.Contains(output, "Requests:") {
+		t.Errorf("unexpected requests in output:\n%s", output)
+	}
 }
@@ -0,0 +1,134 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package revision
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/knative/client/pkg/kn/commands"
+	hprinters "github.com/knative/client/pkg/printers"
+	servinglib "github.com/knative/client/pkg/serving"
+	"github.com/knative/serving/pkg/apis/serving"
+	servingv1alpha1 "github.com/knative/serving/pkg/apis/serving/v1alpha1"
+	serving_v1alpha1_client "github.com/knative/serving/pkg/client/clientset/versioned/typed/serving/v1alpha1"
+	"github.com/spf13/cobra"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NewRevisionDiffCommand represents 'kn revision diff' command
+func NewRevisionDiffCommand(p *commands.KnParams) *cobra.Command {
+	revisionDiffCommand := &cobra.Command{
+		Use:   "diff NAME [NAME]",
+		Short: "Show the differences between two revisions.",
+		Long: `Show the differences between two revisions.
+
+If only one revision is given, it is compared with the previous revision of
+its configuration. The command exits with a non-zero status if the revisions differ.`,
+		Example: `
+  # Compare revision 'svc1-00003' with the revision it succeeded
+  kn revision diff svc1-00003
+
+  # Compare revisions 'svc1-00003' and 'svc1-00001'
+  kn revision diff svc1-00003 svc1-00001`,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) < 1 || len(args) > 2 {
+				return errors.New("requires one or two revision names.")
+			}
+			client, err := p.ServingFactory()
+			if err != nil {
+				return err
+			}
+			namespace, err := commands.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+
+			revision1, err := client.Revisions(namespace).Get(args[0], v1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			var revision2 *servingv1alpha1.Revision
+			if len(args) == 2 {
+				revision2, err = client.Revisions(namespace).Get(args[1], v1.GetOptions{})
+			} else {
+				revision2, err = previousRevision(client, namespace, revision1)
+			}
+			if err != nil {
+				return err
+			}
+
+			differences, err := servinglib.DiffRevisions(revision1, revision2)
+			if err != nil {
+				return err
+			}
+			if len(differences) == 0 {
+				fmt.Fprintf(cmd.OutOrStdout(), "Revisions '%s' and '%s' don't differ.\n", revision1.Name, revision2.Name)
+				return nil
+			}
+
+			w := hprinters.GetNewTabWriter(cmd.OutOrStdout())
+			fmt.Fprintf(w, "CATEGORY\tFIELD\t%s\t%s\n", revision1.Name, revision2.Name)
+			category := ""
+			for _, difference := range differences {
+				// print each category only once, for its first difference
+				label := ""
+				if difference.Category != category {
+					category = difference.Category
+					label = category
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", label, difference.Field, valueOrUnset(difference.Value1), valueOrUnset(difference.Value2))
+			}
+			err = w.Flush()
+			if err != nil {
+				return err
+			}
+			return fmt.Errorf("revisions '%s' and '%s' differ", revision1.Name, revision2.Name)
+		},
+	}
+	commands.AddNamespaceFlags(revisionDiffCommand.Flags(), false)
+	return revisionDiffCommand
+}
+
+// previousRevision finds the revision of the same configuration with the
+// highest generation below the generation of the given revision
+func previousRevision(client serving_v1alpha1_client.ServingV1alpha1Interface, namespace string, revision *servingv1alpha1.Revision) (*servingv1alpha1.Revision, error) {
+	configuration := revision.Labels[serving.ConfigurationLabelKey]
+	generation := servinglib.RevisionGeneration(revision)
+	if configuration == "" || generation == 0 {
+		return nil, fmt.Errorf("can't determine the previous revision of '%s', please give a second revision name.", revision.Name)
+	}
+	revisions, err := client.Revisions(namespace).List(v1.ListOptions{
+		LabelSelector: serving.ConfigurationLabelKey + "=" + configuration,
+	})
+	if err != nil {
+		return nil, err
+	}
+	servinglib.SortRevisionsByGeneration(revisions.Items)
+	for i := range revisions.Items {
+		if previous := servinglib.RevisionGeneration(&revisions.Items[i]); previous > 0 && previous < generation {
+			return &revisions.Items[i], nil
+		}
+	}
+	return nil, fmt.Errorf("no revision of configuration '%s' precedes '%s'.", configuration, revision.Name)
+}
+
+func valueOrUnset(value string) string {
+	if value == "" {
+		return "<unset>"
+	}
+	return value
+}
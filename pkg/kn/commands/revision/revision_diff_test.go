@@ -0,0 +1,104 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package revision
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/knative/serving/pkg/apis/serving"
+	"github.com/knative/serving/pkg/apis/serving/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	client_testing "k8s.io/client-go/testing"
+)
+
+func newGenerationRevision(generation int, image string) v1alpha1.Revision {
+	return v1alpha1.Revision{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "foo-" + strconv.Itoa(generation),
+			Labels: map[string]string{
+				serving.ConfigurationLabelKey:           "foo",
+				serving.ConfigurationGenerationLabelKey: strconv.Itoa(generation),
+			},
+		},
+		Spec: v1alpha1.RevisionSpec{
+			DeprecatedContainer: &corev1.Container{Image: image},
+		},
+	}
+}
+
+func fakeRevisionDiff(args []string, revisions []v1alpha1.Revision) (output string, err error) {
+	knParams := &commands.KnParams{}
+	cmd, fakeServing, buf := commands.CreateTestKnCommand(NewRevisionCommand(knParams), knParams)
+	fakeServing.AddReactor("get", "revisions",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			name := a.(client_testing.GetAction).GetName()
+			for i := range revisions {
+				if revisions[i].Name == name {
+					return true, &revisions[i], nil
+				}
+			}
+			return true, nil, nil
+		})
+	fakeServing.AddReactor("list", "revisions",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			return true, &v1alpha1.RevisionList{Items: revisions}, nil
+		})
+	cmd.SetArgs(args)
+	err = cmd.Execute()
+	output = buf.String()
+	return
+}
+
+func TestRevisionDiffPrevious(t *testing.T) {
+	revisions := []v1alpha1.Revision{
+		newGenerationRevision(1, "gcr.io/foo/bar:v1"),
+		newGenerationRevision(3, "gcr.io/foo/bar:v3"),
+		newGenerationRevision(2, "gcr.io/foo/bar:v2"),
+	}
+	output, err := fakeRevisionDiff([]string{"revision", "diff", "foo-3"}, revisions)
+	if err == nil || err.Error() != "revisions 'foo-3' and 'foo-2' differ" {
+		t.Fatalf("expected error for differing revisions, got %v", err)
+	}
+	lines := strings.Split(output, "\n")
+	testContains(t, lines[0], []string{"CATEGORY", "FIELD", "foo-3", "foo-2"}, "column header")
+	testContains(t, lines[1], []string{"Image", "image", "gcr.io/foo/bar:v3", "gcr.io/foo/bar:v2"}, "value")
+}
+
+func TestRevisionDiffEqual(t *testing.T) {
+	revisions := []v1alpha1.Revision{
+		newGenerationRevision(1, "gcr.io/foo/bar:v1"),
+		newGenerationRevision(2, "gcr.io/foo/bar:v1"),
+	}
+	output, err := fakeRevisionDiff([]string{"revision", "diff", "foo-1", "foo-2"}, revisions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(output, "Revisions 'foo-1' and 'foo-2' don't differ.") {
+		t.Errorf("unexpected output: %s", output)
+	}
+}
+
+func TestRevisionDiffNoPrevious(t *testing.T) {
+	revisions := []v1alpha1.Revision{newGenerationRevision(1, "gcr.io/foo/bar:v1")}
+	_, err := fakeRevisionDiff([]string{"revision", "diff", "foo-1"}, revisions)
+	if err == nil || !strings.Contains(err.Error(), "no revision of configuration 'foo' precedes 'foo-1'") {
+		t.Fatalf("expected error for missing previous revision, got %v", err)
+	}
+}
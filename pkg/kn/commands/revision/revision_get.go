@@ -18,6 +18,8 @@ import (
 	"fmt"
 
 	"github.com/knative/client/pkg/kn/commands"
+	servinglib "github.com/knative/client/pkg/serving"
+	"github.com/knative/serving/pkg/apis/serving"
 	"github.com/spf13/cobra"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -26,10 +28,17 @@ import (
 // NewRevisionGetCommand represents 'kn revision get' command
 func NewRevisionGetCommand(p *commands.KnParams) *cobra.Command {
 	revisionGetFlags := NewRevisionGetFlags()
+	var serviceName string
 
 	revisionGetCommand := &cobra.Command{
 		Use:   "get",
 		Short: "Get available revisions.",
+		Example: `
+  # Get all revisions in default namespace, newest first
+  kn revision get
+
+  # Get the revisions of service 'svc1' and the share of traffic they receive
+  kn revision get --service svc1`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			client, err := p.ServingFactory()
 			if err != nil {
@@ -39,7 +48,11 @@ func NewRevisionGetCommand(p *commands.KnParams) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			revision, err := client.Revisions(namespace).List(v1.ListOptions{})
+			listOptions := v1.ListOptions{}
+			if serviceName != "" {
+				listOptions.LabelSelector = serving.ServiceLabelKey + "=" + serviceName
+			}
+			revision, err := client.Revisions(namespace).List(listOptions)
 			if err != nil {
 				return err
 			}
@@ -47,11 +60,25 @@ func NewRevisionGetCommand(p *commands.KnParams) *cobra.Command {
 				fmt.Fprintf(cmd.OutOrStdout(), "No resources found.\n")
 				return nil
 			}
+			servinglib.SortRevisionsByGeneration(revision.Items)
+
+			// the share of traffic is taken from the route of the revision's
+			// service, which is independent of --service
+			routes, err := client.Routes(namespace).List(v1.ListOptions{LabelSelector: serving.ServiceLabelKey})
+			if err != nil {
+				return err
+			}
+			traffic := map[string]*servinglib.RevisionTraffic{}
+			for i := range revision.Items {
+				if revisionTraffic := servinglib.GetServiceTraffic(routes.Items, &revision.Items[i]); revisionTraffic != nil {
+					traffic[revision.Items[i].Namespace+"/"+revision.Items[i].Name] = revisionTraffic
+				}
+			}
 			revision.GetObjectKind().SetGroupVersionKind(schema.GroupVersionKind{
 				Group:   "knative.dev",
 				Version: "v1alpha1",
 				Kind:    "revision"})
-			printer, err := revisionGetFlags.ToPrinter()
+			printer, err := revisionGetFlags.ToPrinter(traffic)
 			if err != nil {
 				return err
 			}
@@ -64,5 +91,6 @@ func NewRevisionGetCommand(p *commands.KnParams) *cobra.Command {
 	}
 	commands.AddNamespaceFlags(revisionGetCommand.Flags(), true)
 	revisionGetFlags.AddFlags(revisionGetCommand)
+	revisionGetCommand.Flags().StringVar(&serviceName, "service", "", "Only get the revisions of the given service.")
 	return revisionGetCommand
 }
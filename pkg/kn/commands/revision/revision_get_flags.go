@@ -17,6 +17,7 @@ package revision
 import (
 	"github.com/knative/client/pkg/kn/commands"
 	hprinters "github.com/knative/client/pkg/printers"
+	servinglib "github.com/knative/client/pkg/serving"
 	"github.com/spf13/cobra"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 )
@@ -36,18 +37,20 @@ func (f *RevisionGetFlags) AllowedFormats() []string {
 }
 
 // ToPrinter attempts to find a composed set of RevisionGetFlags suitable for
-// returning a printer based on current flag values.
-func (f *RevisionGetFlags) ToPrinter() (hprinters.ResourcePrinter, error) {
+// returning a printer based on current flag values. The table printer shows
+// the given traffic of the revisions, keyed by "namespace/name".
+func (f *RevisionGetFlags) ToPrinter(traffic map[string]*servinglib.RevisionTraffic) (hprinters.ResourcePrinter, error) {
+	outputFormat := *f.GenericPrintFlags.OutputFormat
 	// if there are flags specified for generic printing
-	if f.GenericPrintFlags.OutputFlagSpecified() {
+	if f.GenericPrintFlags.OutputFlagSpecified() && !f.HumanReadableFlags.IsHumanReadableFormat(outputFormat) {
 		p, err := f.GenericPrintFlags.ToPrinter()
 		if err != nil {
 			return nil, err
 		}
 		return p, nil
 	}
-	// if no flags specified, use the table printing
-	p, err := f.HumanReadableFlags.ToPrinter(RevisionGetHandlers)
+	// otherwise use the table printing
+	p, err := f.HumanReadableFlags.ToPrinter(outputFormat, RevisionGetHandlers(traffic))
 	if err != nil {
 		return nil, err
 	}
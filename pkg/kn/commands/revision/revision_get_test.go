@@ -21,19 +21,28 @@ import (
 	"github.com/knative/client/pkg/kn/commands"
 	serving "github.com/knative/serving/pkg/apis/serving"
 	v1alpha1 "github.com/knative/serving/pkg/apis/serving/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	client_testing "k8s.io/client-go/testing"
 )
 
 func fakeRevisionGet(args []string, response *v1alpha1.RevisionList) (action client_testing.Action, output []string, err error) {
+	return fakeRevisionGetWithRoutes(args, response, &v1alpha1.RouteList{})
+}
+
+func fakeRevisionGetWithRoutes(args []string, response *v1alpha1.RevisionList, routes *v1alpha1.RouteList) (action client_testing.Action, output []string, err error) {
 	knParams := &commands.KnParams{}
 	cmd, fakeServing, buf := commands.CreateTestKnCommand(NewRevisionCommand(knParams), knParams)
-	fakeServing.AddReactor("*", "*",
+	fakeServing.AddReactor("list", "revisions",
 		func(a client_testing.Action) (bool, runtime.Object, error) {
 			action = a
 			return true, response, nil
 		})
+	fakeServing.AddReactor("list", "routes",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			return true, routes, nil
+		})
 	cmd.SetArgs(args)
 	err = cmd.Execute()
 	if err != nil {
@@ -71,11 +80,94 @@ func TestRevisionGetDefaultOutput(t *testing.T) {
 	} else if !action.Matches("list", "revisions") {
 		t.Errorf("Bad action %v", action)
 	}
-	testContains(t, output[0], []string{"SERVICE", "NAME", "AGE", "CONDITIONS", "READY", "REASON"}, "column header")
+	testContains(t, output[0], []string{"SERVICE", "NAME", "TRAFFIC", "TAGS", "AGE", "CONDITIONS", "READY", "REASON"}, "column header")
 	testContains(t, output[1], []string{"foo", "foo-abcd"}, "value")
 	testContains(t, output[2], []string{"bar", "bar-wxyz"}, "value")
 }
 
+func TestRevisionGetWideOutput(t *testing.T) {
+	revision := createMockRevisionWithParams("foo-abcd", "foo")
+	revision.Spec.DeprecatedContainer = &corev1.Container{Image: "gcr.io/foo/bar:v1"}
+	revision.Spec.ContainerConcurrency = 10
+	revisionList := &v1alpha1.RevisionList{Items: []v1alpha1.Revision{*revision}}
+	_, output, err := fakeRevisionGet([]string{"revision", "get", "-o", "wide"}, revisionList)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testContains(t, output[0], []string{"SERVICE", "NAME", "TRAFFIC", "READY", "IMAGE", "CONCURRENCY"}, "column header")
+	testContains(t, output[1], []string{"foo", "foo-abcd", "gcr.io/foo/bar:v1", "limit 10"}, "value")
+}
+
+func TestRevisionGetServiceTraffic(t *testing.T) {
+	revision1 := createMockRevisionWithParams("foo-abcd", "foo")
+	revision1.Labels[serving.ConfigurationGenerationLabelKey] = "1"
+	revision2 := createMockRevisionWithParams("foo-efgh", "foo")
+	revision2.Labels[serving.ConfigurationGenerationLabelKey] = "2"
+	revision3 := createMockRevisionWithParams("foo-ijkl", "foo")
+	revision3.Labels[serving.ConfigurationGenerationLabelKey] = "3"
+	for _, revision := range []*v1alpha1.Revision{revision1, revision2, revision3} {
+		revision.Labels[serving.ServiceLabelKey] = "foo"
+	}
+	revisionList := &v1alpha1.RevisionList{Items: []v1alpha1.Revision{*revision1, *revision3, *revision2}}
+
+	route := v1alpha1.Route{ObjectMeta: metav1.ObjectMeta{
+		Name:      "foo",
+		Namespace: "default",
+		Labels:    map[string]string{serving.ServiceLabelKey: "foo"},
+	}}
+	route.Status.Traffic = make([]v1alpha1.TrafficTarget, 2)
+	route.Status.Traffic[0].RevisionName, route.Status.Traffic[0].Percent = "foo-abcd", 90
+	route.Status.Traffic[1].RevisionName, route.Status.Traffic[1].Percent = "foo-efgh", 10
+	route.Status.Traffic[1].Tag = "candidate"
+	routes := &v1alpha1.RouteList{Items: []v1alpha1.Route{route}}
+
+	action, output, err := fakeRevisionGetWithRoutes([]string{"revision", "get", "--service", "foo"}, revisionList, routes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	selector := action.(client_testing.ListAction).GetListRestrictions().Labels.String()
+	if selector != serving.ServiceLabelKey+"=foo" {
+		t.Errorf("Bad label selector %s", selector)
+	}
+	testContains(t, output[1], []string{"foo-ijkl"}, "value")
+	testContains(t, output[2], []string{"foo-efgh", "10%", "candidate"}, "value")
+	testContains(t, output[3], []string{"foo-abcd", "90%"}, "value")
+	if strings.Contains(output[1], "%") {
+		t.Errorf("Unexpected traffic for revision without traffic: %s", output[1])
+	}
+}
+
+func TestRevisionGetTrafficOfSeveralRoutes(t *testing.T) {
+	revision := createMockRevisionWithParams("foo-abcd", "foo")
+	revision.Labels[serving.ServiceLabelKey] = "foo"
+	revisionList := &v1alpha1.RevisionList{Items: []v1alpha1.Revision{*revision}}
+
+	// the service's route and a standalone route pinning the revision
+	routes := &v1alpha1.RouteList{Items: make([]v1alpha1.Route, 2)}
+	for i, target := range []struct {
+		route, tag string
+		percent    int
+	}{{"foo", "current", 50}, {"pinned", "pinned", 100}} {
+		routes.Items[i].Name, routes.Items[i].Namespace = target.route, "default"
+		routes.Items[i].Status.Traffic = make([]v1alpha1.TrafficTarget, 1)
+		routes.Items[i].Status.Traffic[0].RevisionName = "foo-abcd"
+		routes.Items[i].Status.Traffic[0].Percent, routes.Items[i].Status.Traffic[0].Tag = target.percent, target.tag
+	}
+	routes.Items[0].Labels = map[string]string{serving.ServiceLabelKey: "foo"}
+
+	// the traffic shown doesn't depend on filtering by service
+	for _, args := range [][]string{{"revision", "get"}, {"revision", "get", "--service", "foo"}} {
+		_, output, err := fakeRevisionGetWithRoutes(args, revisionList, routes)
+		if err != nil {
+			t.Fatal(err)
+		}
+		testContains(t, output[1], []string{"foo-abcd", "50%", "current"}, "value")
+		if strings.Contains(output[1], "100%") || strings.Contains(output[1], "pinned") {
+			t.Errorf("unexpected traffic of standalone route for %v: %s", args, output[1])
+		}
+	}
+}
+
 func testContains(t *testing.T, output string, sub []string, element string) {
 	for _, each := range sub {
 		if !strings.Contains(output, each) {
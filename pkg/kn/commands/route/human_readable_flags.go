@@ -0,0 +1,106 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package route
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/knative/client/pkg/kn/commands"
+	hprinters "github.com/knative/client/pkg/printers"
+	servingv1alpha1 "github.com/knative/serving/pkg/apis/serving/v1alpha1"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// RouteGetHandlers adds print handlers for route get command
+func RouteGetHandlers(h hprinters.PrintHandler) {
+	routeColumnDefinitions := []metav1beta1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Description: "Name of the route."},
+		{Name: "URL", Type: "string", Description: "URL of the route."},
+		{Name: "Traffic", Type: "string", Description: "Traffic targets of the route."},
+		{Name: "Age", Type: "string", Description: "Age of the route."},
+		{Name: "Conditions", Type: "string", Description: "Conditions describing statuses of route components."},
+		{Name: "Ready", Type: "string", Description: "Ready condition status of the route."},
+		{Name: "Reason", Type: "string", Description: "Reason for non-ready condition of the route."},
+	}
+	h.TableHandler(routeColumnDefinitions, printRoute)
+	h.TableHandler(routeColumnDefinitions, printRouteList)
+}
+
+// Private functions
+
+// printRouteList populates the route list table rows
+func printRouteList(routeList *servingv1alpha1.RouteList, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
+	rows := make([]metav1beta1.TableRow, 0, len(routeList.Items))
+	for _, route := range routeList.Items {
+		r, err := printRoute(&route, options)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, r...)
+	}
+	return rows, nil
+}
+
+// printRoute populates the route table rows
+func printRoute(route *servingv1alpha1.Route, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
+	name := route.Name
+	url := routeURL(route)
+	targets := make([]string, 0, len(route.Status.Traffic))
+	for _, target := range route.Status.Traffic {
+		targets = append(targets, fmt.Sprintf("%d%% -> %s", target.Percent, trafficTargetName(target)))
+	}
+	traffic := strings.Join(targets, ", ")
+	age := commands.TranslateTimestampSince(route.CreationTimestamp)
+	conditions := commands.ConditionsValue(route.Status.Conditions)
+	ready := commands.ReadyCondition(route.Status.Conditions)
+	reason := commands.NonReadyConditionReason(route.Status.Conditions)
+
+	row := metav1beta1.TableRow{
+		Object: runtime.RawExtension{Object: route},
+	}
+	row.Cells = append(row.Cells,
+		name,
+		url,
+		traffic,
+		age,
+		conditions,
+		ready,
+		reason)
+	return []metav1beta1.TableRow{row}, nil
+}
+
+// routeURL returns the URL of the route, falling back to its domain for
+// older serving versions
+func routeURL(route *servingv1alpha1.Route) string {
+	if route.Status.URL != nil {
+		return route.Status.URL.String()
+	}
+	return route.Status.DeprecatedDomain
+}
+
+// trafficTargetName returns the revision a traffic target points to,
+// together with its tag if it has one
+func trafficTargetName(target servingv1alpha1.TrafficTarget) string {
+	name := target.RevisionName
+	if name == "" {
+		name = target.ConfigurationName
+	}
+	if target.Tag != "" {
+		name = fmt.Sprintf("%s (%s)", name, target.Tag)
+	}
+	return name
+}
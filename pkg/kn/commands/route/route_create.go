@@ -0,0 +1,90 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package route
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/knative/client/pkg/kn/commands"
+	servingv1alpha1 "github.com/knative/serving/pkg/apis/serving/v1alpha1"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NewRouteCreateCommand represents 'kn route create' command
+func NewRouteCreateCommand(p *commands.KnParams) *cobra.Command {
+	var trafficFlags TrafficFlags
+
+	routeCreateCommand := &cobra.Command{
+		Use:   "create NAME --traffic KIND:NAME=PERCENT",
+		Short: "Create a route.",
+		Long: `Create a route.
+
+A route distributes its traffic across revisions and configurations,
+which may belong to different services.`,
+		Example: `
+  # Create route 'myroute' splitting the traffic between the configurations 'a' and 'b'
+  kn route create myroute --traffic configuration:a=90 --traffic configuration:b=10
+
+  # Create route 'stable' sending all traffic to revision 'a-00001', also reachable under tag 'v1'
+  kn route create stable --traffic revision:a-00001=100 --tag revision:a-00001=v1`,
+
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			if len(args) != 1 {
+				return errors.New("requires the route name.")
+			}
+			if len(trafficFlags.Traffic) == 0 {
+				return errors.New("requires at least one traffic target.")
+			}
+
+			namespace, err := commands.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			targets, err := trafficFlags.targets(nil)
+			if err != nil {
+				return err
+			}
+			client, err := p.ServingFactory()
+			if err != nil {
+				return err
+			}
+			err = checkTargetsExist(client, namespace, targets)
+			if err != nil {
+				return err
+			}
+
+			route := servingv1alpha1.Route{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      args[0],
+					Namespace: namespace,
+				},
+				Spec: servingv1alpha1.RouteSpec{
+					Traffic: targets,
+				},
+			}
+			_, err = client.Routes(namespace).Create(&route)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Route '%s' successfully created in namespace '%s'.\n", args[0], namespace)
+			return nil
+		},
+	}
+	commands.AddNamespaceFlags(routeCreateCommand.Flags(), false)
+	trafficFlags.AddFlags(routeCreateCommand)
+	return routeCreateCommand
+}
@@ -0,0 +1,132 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package route
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/knative/serving/pkg/apis/serving/v1alpha1"
+	api_errors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	client_testing "k8s.io/client-go/testing"
+)
+
+// fakeRouteEdit runs a route command against a fake with the given route,
+// configurations and revisions, returning the created or updated route
+func fakeRouteEdit(args []string, route *v1alpha1.Route, configurations []string, revisions []string) (
+	edited *v1alpha1.Route,
+	output string,
+	err error) {
+	knParams := &commands.KnParams{}
+	cmd, fakeServing, buf := commands.CreateTestKnCommand(NewRouteCommand(knParams), knParams)
+	fakeServing.AddReactor("get", "routes",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			if route == nil {
+				return true, nil, api_errors.NewNotFound(v1alpha1.Resource("routes"), a.(client_testing.GetAction).GetName())
+			}
+			return true, route, nil
+		})
+	getReactor := func(resource string, names []string, object func(name string) runtime.Object) client_testing.ReactionFunc {
+		return func(a client_testing.Action) (bool, runtime.Object, error) {
+			name := a.(client_testing.GetAction).GetName()
+			for _, existing := range names {
+				if existing == name {
+					return true, object(name), nil
+				}
+			}
+			return true, nil, api_errors.NewNotFound(v1alpha1.Resource(resource), name)
+		}
+	}
+	fakeServing.AddReactor("get", "configurations", getReactor("configurations", configurations, func(name string) runtime.Object {
+		return &v1alpha1.Configuration{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	}))
+	fakeServing.AddReactor("get", "revisions", getReactor("revisions", revisions, func(name string) runtime.Object {
+		return &v1alpha1.Revision{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	}))
+	editReactor := func(a client_testing.Action) (bool, runtime.Object, error) {
+		objectAction, ok := a.(client_testing.CreateAction)
+		if !ok {
+			return true, nil, fmt.Errorf("wrong kind of action %v", a)
+		}
+		edited, ok = objectAction.GetObject().(*v1alpha1.Route)
+		if !ok {
+			return true, nil, errors.New("was passed the wrong object")
+		}
+		return true, edited, nil
+	}
+	fakeServing.AddReactor("create", "routes", editReactor)
+	fakeServing.AddReactor("update", "routes", editReactor)
+	cmd.SetArgs(args)
+	err = cmd.Execute()
+	output = buf.String()
+	return
+}
+
+func TestRouteCreate(t *testing.T) {
+	created, output, err := fakeRouteEdit([]string{"route", "create", "foo",
+		"--traffic", "configuration:a=90", "--traffic", "revision:b-00001=10", "--tag", "revision:b-00001=b"},
+		nil, []string{"a"}, []string{"b-00001"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.Name != "foo" || created.Namespace != "default" {
+		t.Errorf("wrong route created: %s/%s", created.Namespace, created.Name)
+	}
+	traffic := created.Spec.Traffic
+	if len(traffic) != 2 ||
+		traffic[0].ConfigurationName != "a" || traffic[0].Percent != 90 ||
+		traffic[1].RevisionName != "b-00001" || traffic[1].Percent != 10 || traffic[1].Tag != "b" {
+		t.Errorf("wrong traffic %v", traffic)
+	}
+	if !strings.Contains(output, "Route 'foo' successfully created in namespace 'default'.") {
+		t.Errorf("unexpected output: %s", output)
+	}
+}
+
+func TestRouteCreateNoTraffic(t *testing.T) {
+	_, _, err := fakeRouteEdit([]string{"route", "create", "foo"}, nil, nil, nil)
+	if err == nil || err.Error() != "requires at least one traffic target." {
+		t.Fatalf("expected error for missing traffic, got %v", err)
+	}
+}
+
+func TestRouteCreateWrongPercent(t *testing.T) {
+	created, _, err := fakeRouteEdit([]string{"route", "create", "foo",
+		"--traffic", "configuration:a=90", "--traffic", "configuration:b=20"},
+		nil, []string{"a", "b"}, nil)
+	if err == nil || err.Error() != "traffic percentages sum up to 110, expected 100" {
+		t.Fatalf("expected error for wrong percentages, got %v", err)
+	}
+	if created != nil {
+		t.Errorf("route created")
+	}
+}
+
+func TestRouteCreateMissingTarget(t *testing.T) {
+	created, _, err := fakeRouteEdit([]string{"route", "create", "foo",
+		"--traffic", "configuration:a=50", "--traffic", "revision:b-00001=50"},
+		nil, []string{"a"}, nil)
+	if err == nil || err.Error() != "revision 'b-00001' not found in namespace 'default'." {
+		t.Fatalf("expected error for missing revision, got %v", err)
+	}
+	if created != nil {
+		t.Errorf("route created")
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package route
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/knative/client/pkg/kn/commands"
+	hprinters "github.com/knative/client/pkg/printers"
+	"github.com/knative/serving/pkg/apis/serving"
+	servingv1alpha1 "github.com/knative/serving/pkg/apis/serving/v1alpha1"
+	"github.com/spf13/cobra"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+// NewRouteDescribeCommand represents 'kn route describe' command
+func NewRouteDescribeCommand(p *commands.KnParams) *cobra.Command {
+	routeDescribePrintFlags := genericclioptions.NewPrintFlags("")
+	routeDescribeCommand := &cobra.Command{
+		Use:   "describe NAME",
+		Short: "Describe available routes.",
+		Example: `
+  # Describe route 'svc1' in default namespace
+  kn route describe svc1
+
+  # Print route 'svc1' as JSON
+  kn route describe svc1 -o json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) < 1 {
+				return errors.New("requires the route name.")
+			}
+			client, err := p.ServingFactory()
+			if err != nil {
+				return err
+			}
+
+			namespace, err := commands.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			route, err := client.Routes(namespace).Get(args[0], v1.GetOptions{})
+			if err != nil {
+				return err
+			}
+
+			if routeDescribePrintFlags.OutputFlagSpecified() {
+				printer, err := routeDescribePrintFlags.ToPrinter()
+				if err != nil {
+					return err
+				}
+				route.GetObjectKind().SetGroupVersionKind(servingv1alpha1.SchemeGroupVersion.WithKind("Route"))
+				return printer.PrintObj(route, cmd.OutOrStdout())
+			}
+			return describeRoute(cmd.OutOrStdout(), route)
+		},
+	}
+	commands.AddNamespaceFlags(routeDescribeCommand.Flags(), false)
+	routeDescribePrintFlags.AddFlags(routeDescribeCommand)
+	return routeDescribeCommand
+}
+
+// describeRoute prints the URL of the route, its traffic targets and its
+// conditions
+func describeRoute(out io.Writer, route *servingv1alpha1.Route) error {
+	w := hprinters.GetNewTabWriter(out)
+	field := commands.FieldPrinter(w)
+	field("Name", route.Name)
+	field("Namespace", route.Namespace)
+	field("Age", commands.TranslateTimestampSince(route.CreationTimestamp))
+	field("Service", route.Labels[serving.ServiceLabelKey])
+	field("URL", routeURL(route))
+	err := w.Flush()
+	if err != nil {
+		return err
+	}
+
+	if len(route.Status.Traffic) > 0 {
+		fmt.Fprintln(out, "\nTraffic:")
+		w = hprinters.GetNewTabWriter(out)
+		fmt.Fprintln(w, "  PERCENT\tREVISION\tTAG\tURL")
+		for _, target := range route.Status.Traffic {
+			revision := target.RevisionName
+			if revision == "" {
+				revision = target.ConfigurationName
+			}
+			url := ""
+			if target.URL != nil {
+				url = target.URL.String()
+			}
+			fmt.Fprintf(w, "  %d%%\t%s\t%s\t%s\n", target.Percent, revision, target.Tag, url)
+		}
+		err = w.Flush()
+		if err != nil {
+			return err
+		}
+	}
+
+	return commands.PrintConditions(out, route.Status.Conditions)
+}
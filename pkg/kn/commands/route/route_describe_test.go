@@ -0,0 +1,96 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package route
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/knative/pkg/apis"
+	"github.com/knative/serving/pkg/apis/serving"
+	"github.com/knative/serving/pkg/apis/serving/v1alpha1"
+	"k8s.io/apimachinery/pkg/runtime"
+	client_testing "k8s.io/client-go/testing"
+	"sigs.k8s.io/yaml"
+)
+
+func fakeRouteDescribe(args []string, response *v1alpha1.Route) (action client_testing.Action, output string, err error) {
+	knParams := &commands.KnParams{}
+	cmd, fakeServing, buf := commands.CreateTestKnCommand(NewRouteCommand(knParams), knParams)
+	fakeServing.AddReactor("get", "routes",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			action = a
+			return true, response, nil
+		})
+	cmd.SetArgs(args)
+	err = cmd.Execute()
+	if err != nil {
+		return
+	}
+	output = buf.String()
+	return
+}
+
+func TestRouteDescribeWithNoName(t *testing.T) {
+	_, _, err := fakeRouteDescribe([]string{"route", "describe"}, &v1alpha1.Route{})
+	if err == nil || err.Error() != "requires the route name." {
+		t.Fatal("expect to fail with missing route name")
+	}
+}
+
+func TestRouteDescribe(t *testing.T) {
+	route := createMockRoute("foo", "default",
+		createTrafficTarget("foo-2", "", 80),
+		createTrafficTarget("foo-1", "old", 20))
+	route.Labels = map[string]string{serving.ServiceLabelKey: "foo"}
+	route.Status.Traffic[1].URL = &apis.URL{Scheme: "http", Host: "old-foo.default.example.com"}
+	action, output, err := fakeRouteDescribe([]string{"route", "describe", "foo"}, route)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !action.Matches("get", "routes") || action.(client_testing.GetAction).GetName() != "foo" {
+		t.Errorf("Bad action %v", action)
+	}
+	lines := strings.Split(output, "\n")
+	testContains(t, lines[0], []string{"Name:", "foo"}, "field")
+	testContains(t, output, []string{"Service:", "URL:", "http://foo.default.example.com"}, "field")
+	testContains(t, output, []string{"PERCENT", "REVISION", "TAG"}, "traffic header")
+	for _, line := range lines {
+		if strings.Contains(line, "foo-1") {
+			testContains(t, line, []string{"20%", "old", "http://old-foo.default.example.com"}, "traffic target")
+		}
+	}
+	testContains(t, output, []string{"Conditions:", "Ready", "True"}, "conditions")
+}
+
+func TestRouteDescribeYaml(t *testing.T) {
+	route := createMockRoute("foo", "default", createTrafficTarget("foo-1", "", 100))
+	_, output, err := fakeRouteDescribe([]string{"route", "describe", "foo", "-o", "yaml"}, route)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var returned v1alpha1.Route
+	err = yaml.Unmarshal([]byte(output), &returned)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if returned.APIVersion != "serving.knative.dev/v1alpha1" || returned.Kind != "Route" {
+		t.Errorf("Unexpected type %s/%s", returned.APIVersion, returned.Kind)
+	}
+	if returned.Status.Traffic[0].RevisionName != "foo-1" {
+		t.Errorf("Traffic not printed: %v", returned.Status.Traffic)
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package route
+
+import (
+	"fmt"
+
+	"github.com/knative/client/pkg/kn/commands"
+	servingv1alpha1 "github.com/knative/serving/pkg/apis/serving/v1alpha1"
+	"github.com/spf13/cobra"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NewRouteGetCommand represents 'kn route get' command
+func NewRouteGetCommand(p *commands.KnParams) *cobra.Command {
+	routeGetFlags := NewRouteGetFlags()
+
+	routeGetCommand := &cobra.Command{
+		Use:   "get",
+		Short: "Get available routes.",
+		Example: `
+  # Get all routes in default namespace
+  kn route get
+
+  # Get all routes in all namespaces as YAML
+  kn route get --all-namespaces -o yaml`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := p.ServingFactory()
+			if err != nil {
+				return err
+			}
+			namespace, err := commands.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			route, err := client.Routes(namespace).List(v1.ListOptions{})
+			if err != nil {
+				return err
+			}
+			if len(route.Items) == 0 {
+				fmt.Fprintf(cmd.OutOrStdout(), "No resources found.\n")
+				return nil
+			}
+			route.GetObjectKind().SetGroupVersionKind(servingv1alpha1.SchemeGroupVersion.WithKind("RouteList"))
+
+			printer, err := routeGetFlags.ToPrinter()
+			if err != nil {
+				return err
+			}
+
+			err = printer.PrintObj(route, cmd.OutOrStdout())
+			if err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+	commands.AddNamespaceFlags(routeGetCommand.Flags(), true)
+	routeGetFlags.AddFlags(routeGetCommand)
+	return routeGetCommand
+}
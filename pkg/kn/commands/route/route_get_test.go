@@ -0,0 +1,145 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package route
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/knative/pkg/apis"
+	duckv1beta1 "github.com/knative/pkg/apis/duck/v1beta1"
+	"github.com/knative/serving/pkg/apis/serving/v1alpha1"
+	"github.com/knative/serving/pkg/apis/serving/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	client_testing "k8s.io/client-go/testing"
+)
+
+func fakeRouteGet(args []string, response *v1alpha1.RouteList) (action client_testing.Action, output []string, err error) {
+	knParams := &commands.KnParams{}
+	cmd, fakeServing, buf := commands.CreateTestKnCommand(NewRouteCommand(knParams), knParams)
+	fakeServing.AddReactor("list", "routes",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			action = a
+			return true, response, nil
+		})
+	cmd.SetArgs(args)
+	err = cmd.Execute()
+	if err != nil {
+		return
+	}
+	output = strings.Split(buf.String(), "\n")
+	return
+}
+
+func TestRouteGetEmpty(t *testing.T) {
+	action, output, err := fakeRouteGet([]string{"route", "get"}, &v1alpha1.RouteList{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if action == nil {
+		t.Errorf("No action")
+	} else if !action.Matches("list", "routes") {
+		t.Errorf("Bad action %v", action)
+	} else if output[0] != "No resources found." {
+		t.Errorf("Bad output %s", output[0])
+	}
+}
+
+func TestRouteGetDefaultOutput(t *testing.T) {
+	route1 := createMockRoute("foo", "default",
+		createTrafficTarget("foo-2", "", 80),
+		createTrafficTarget("foo-1", "old", 20))
+	route2 := createMockRoute("bar", "default", createTrafficTarget("bar-1", "", 100))
+	routeList := &v1alpha1.RouteList{Items: []v1alpha1.Route{*route1, *route2}}
+	action, output, err := fakeRouteGet([]string{"route", "get"}, routeList)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if action.GetNamespace() != "default" {
+		t.Errorf("Bad namespace %s", action.GetNamespace())
+	}
+	testContains(t, output[0], []string{"NAME", "URL", "TRAFFIC", "AGE", "CONDITIONS", "READY", "REASON"}, "column header")
+	testContains(t, output[1], []string{"foo", "http://foo.default.example.com", "80% -> foo-2, 20% -> foo-1 (old)", "True"}, "value")
+	testContains(t, output[2], []string{"bar", "http://bar.default.example.com", "100% -> bar-1"}, "value")
+}
+
+func TestRouteGetAllNamespaces(t *testing.T) {
+	route := createMockRoute("foo", "other", createTrafficTarget("foo-1", "", 100))
+	action, output, err := fakeRouteGet([]string{"route", "get", "--all-namespaces"}, &v1alpha1.RouteList{Items: []v1alpha1.Route{*route}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if action.GetNamespace() != "" {
+		t.Errorf("Expected listing in all namespaces, got %s", action.GetNamespace())
+	}
+	testContains(t, output[1], []string{"foo", "http://foo.other.example.com"}, "value")
+}
+
+func TestRouteGetYaml(t *testing.T) {
+	route := createMockRoute("foo", "default", createTrafficTarget("foo-1", "", 100))
+	_, output, err := fakeRouteGet([]string{"route", "get", "-o", "yaml"}, &v1alpha1.RouteList{Items: []v1alpha1.Route{*route}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	testContains(t, strings.Join(output, "\n"), []string{"apiVersion: serving.knative.dev/v1alpha1", "kind: RouteList", "revisionName: foo-1", "percent: 100"}, "yaml")
+}
+
+func testContains(t *testing.T, output string, sub []string, element string) {
+	for _, each := range sub {
+		if !strings.Contains(output, each) {
+			t.Errorf("Missing %s: %s", element, each)
+		}
+	}
+}
+
+func createTrafficTarget(revision, tag string, percent int) v1alpha1.TrafficTarget {
+	return v1alpha1.TrafficTarget{
+		TrafficTarget: v1beta1.TrafficTarget{
+			RevisionName: revision,
+			Tag:          tag,
+			Percent:      percent,
+		},
+	}
+}
+
+func createMockRoute(name, namespace string, traffic ...v1alpha1.TrafficTarget) *v1alpha1.Route {
+	return &v1alpha1.Route{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Route",
+			APIVersion: "knative.dev/v1alpha1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Status: v1alpha1.RouteStatus{
+			Status: duckv1beta1.Status{
+				Conditions: duckv1beta1.Conditions{
+					apis.Condition{Type: apis.ConditionReady, Status: corev1.ConditionTrue},
+				},
+			},
+			RouteStatusFields: v1alpha1.RouteStatusFields{
+				URL: &apis.URL{
+					Scheme: "http",
+					Host:   name + "." + namespace + ".example.com",
+				},
+				Traffic: traffic,
+			},
+		},
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package route
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/knative/serving/pkg/apis/serving"
+	"github.com/spf13/cobra"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NewRouteUpdateCommand represents 'kn route update' command
+func NewRouteUpdateCommand(p *commands.KnParams) *cobra.Command {
+	var trafficFlags TrafficFlags
+
+	routeUpdateCommand := &cobra.Command{
+		Use:   "update NAME",
+		Short: "Update a route.",
+		Long: `Update a route.
+
+Traffic given with --traffic replaces the traffic of the route, including
+its tags. Tags given without --traffic are added to the current traffic.
+Routes owned by a service have to be updated through the service.`,
+		Example: `
+  # Shift the traffic of route 'myroute' to configuration 'b'
+  kn route update myroute --traffic configuration:a=10 --traffic configuration:b=90
+
+  # Make revision 'a-00002' of route 'myroute' reachable under tag 'candidate'
+  kn route update myroute --tag revision:a-00002=candidate`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			if len(args) != 1 {
+				return errors.New("requires the route name.")
+			}
+			if len(trafficFlags.Traffic) == 0 && len(trafficFlags.Tags) == 0 {
+				return errors.New("requires --traffic or --tag.")
+			}
+
+			namespace, err := commands.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.ServingFactory()
+			if err != nil {
+				return err
+			}
+
+			route, err := client.Routes(namespace).Get(args[0], v1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			if serviceName := route.Labels[serving.ServiceLabelKey]; serviceName != "" {
+				return fmt.Errorf("route '%s' is owned by service '%s', please use 'kn service update' instead.", args[0], serviceName)
+			}
+			route = route.DeepCopy()
+
+			targets, err := trafficFlags.targets(route.Spec.Traffic)
+			if err != nil {
+				return err
+			}
+			err = checkTargetsExist(client, namespace, targets)
+			if err != nil {
+				return err
+			}
+			route.Spec.Traffic = targets
+
+			_, err = client.Routes(namespace).Update(route)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Route '%s' successfully updated in namespace '%s'.\n", args[0], namespace)
+			return nil
+		},
+	}
+	commands.AddNamespaceFlags(routeUpdateCommand.Flags(), false)
+	trafficFlags.AddFlags(routeUpdateCommand)
+	return routeUpdateCommand
+}
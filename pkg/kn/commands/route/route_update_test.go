@@ -0,0 +1,86 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package route
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/knative/serving/pkg/apis/serving"
+	"github.com/knative/serving/pkg/apis/serving/v1alpha1"
+)
+
+func newRouteWithTraffic(traffic ...v1alpha1.TrafficTarget) *v1alpha1.Route {
+	route := createMockRoute("foo", "default")
+	route.Spec.Traffic = traffic
+	return route
+}
+
+func TestRouteUpdateTraffic(t *testing.T) {
+	original := newRouteWithTraffic(createTrafficTarget("a-00001", "old", 100))
+	updated, output, err := fakeRouteEdit([]string{"route", "update", "foo",
+		"--traffic", "revision:a-00001=20", "--traffic", "revision:a-00002=80"},
+		original, nil, []string{"a-00001", "a-00002"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	traffic := updated.Spec.Traffic
+	if len(traffic) != 2 ||
+		traffic[0].RevisionName != "a-00001" || traffic[0].Percent != 20 || traffic[0].Tag != "" ||
+		traffic[1].RevisionName != "a-00002" || traffic[1].Percent != 80 {
+		t.Errorf("wrong traffic %v", traffic)
+	}
+	if original.Spec.Traffic[0].Percent != 100 {
+		t.Errorf("original route modified")
+	}
+	if !strings.Contains(output, "Route 'foo' successfully updated in namespace 'default'.") {
+		t.Errorf("unexpected output: %s", output)
+	}
+}
+
+func TestRouteUpdateTag(t *testing.T) {
+	original := newRouteWithTraffic(createTrafficTarget("a-00001", "", 100))
+	updated, _, err := fakeRouteEdit([]string{"route", "update", "foo", "--tag", "revision:a-00002=candidate"},
+		original, nil, []string{"a-00001", "a-00002"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	traffic := updated.Spec.Traffic
+	if len(traffic) != 2 ||
+		traffic[0].RevisionName != "a-00001" || traffic[0].Percent != 100 ||
+		traffic[1].RevisionName != "a-00002" || traffic[1].Percent != 0 || traffic[1].Tag != "candidate" {
+		t.Errorf("wrong traffic %v", traffic)
+	}
+}
+
+func TestRouteUpdateNoChange(t *testing.T) {
+	_, _, err := fakeRouteEdit([]string{"route", "update", "foo"}, newRouteWithTraffic(), nil, nil)
+	if err == nil || err.Error() != "requires --traffic or --tag." {
+		t.Fatalf("expected error for missing flags, got %v", err)
+	}
+}
+
+func TestRouteUpdateOwnedByService(t *testing.T) {
+	original := newRouteWithTraffic(createTrafficTarget("a-00001", "", 100))
+	original.Labels = map[string]string{serving.ServiceLabelKey: "a"}
+	updated, _, err := fakeRouteEdit([]string{"route", "update", "foo", "--tag", "revision:a-00001=v1"},
+		original, nil, []string{"a-00001"})
+	if err == nil || err.Error() != "route 'foo' is owned by service 'a', please use 'kn service update' instead." {
+		t.Fatalf("expected error for route of a service, got %v", err)
+	}
+	if updated != nil {
+		t.Errorf("route of a service updated")
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package route
+
+import (
+	"fmt"
+
+	servinglib "github.com/knative/client/pkg/serving"
+	servingv1alpha1 "github.com/knative/serving/pkg/apis/serving/v1alpha1"
+	serving_v1alpha1_client "github.com/knative/serving/pkg/client/clientset/versioned/typed/serving/v1alpha1"
+	"github.com/spf13/cobra"
+	api_errors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TrafficFlags are the flags for distributing the traffic of a route
+type TrafficFlags struct {
+	Traffic []string
+	Tags    []string
+}
+
+func (f *TrafficFlags) AddFlags(command *cobra.Command) {
+	command.Flags().StringArrayVar(&f.Traffic, "traffic", []string{},
+		"Traffic target and its percentage, 'configuration:NAME=PERCENT' or 'revision:NAME=PERCENT'; "+
+			"you may provide this flag any number of times, the percentages have to sum up to 100.")
+	command.Flags().StringArrayVar(&f.Tags, "tag", []string{},
+		"Tag for a traffic target, 'configuration:NAME=TAG' or 'revision:NAME=TAG'; a target which doesn't "+
+			"receive traffic is added with 0 percent.")
+}
+
+// targets computes the traffic targets from the flags. Without --traffic
+// the tags are added to the given targets.
+func (f *TrafficFlags) targets(existing []servingv1alpha1.TrafficTarget) ([]servingv1alpha1.TrafficTarget, error) {
+	targets := append([]servingv1alpha1.TrafficTarget{}, existing...)
+	if len(f.Traffic) > 0 {
+		var err error
+		targets, err = servinglib.ParseTrafficTargets(f.Traffic)
+		if err != nil {
+			return nil, err
+		}
+	}
+	targets, err := servinglib.UpdateTrafficTags(targets, f.Tags)
+	if err != nil {
+		return nil, err
+	}
+	err = servinglib.ValidateTrafficPercent(targets)
+	if err != nil {
+		return nil, err
+	}
+	return targets, nil
+}
+
+// checkTargetsExist verifies that the configurations and revisions the
+// traffic targets point to exist
+func checkTargetsExist(client serving_v1alpha1_client.ServingV1alpha1Interface, namespace string, targets []servingv1alpha1.TrafficTarget) error {
+	checked := map[string]bool{}
+	for _, target := range targets {
+		kind, name := servinglib.TrafficTargetReference(target)
+		if checked[kind+":"+name] {
+			continue
+		}
+		checked[kind+":"+name] = true
+		var err error
+		if kind == servinglib.TrafficTargetRevision {
+			_, err = client.Revisions(namespace).Get(name, v1.GetOptions{})
+		} else {
+			_, err = client.Configurations(namespace).Get(name, v1.GetOptions{})
+		}
+		if api_errors.IsNotFound(err) {
+			return fmt.Errorf("%s '%s' not found in namespace '%s'.", kind, name, namespace)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -15,9 +15,10 @@
 package service
 
 import (
+	"errors"
 	"fmt"
-	"strings"
 
+	"github.com/knative/client/pkg/kn/commands"
 	servinglib "github.com/knative/client/pkg/serving"
 	servingv1alpha1 "github.com/knative/serving/pkg/apis/serving/v1alpha1"
 	"github.com/spf13/cobra"
@@ -34,6 +35,18 @@ type ConfigurationEditFlags struct {
 	MaxScale                   int
 	ConcurrencyTarget          int
 	ConcurrencyLimit           int
+	ClusterLocal               bool
+	NoClusterLocal             bool
+	LockToDigest               bool
+	InsecureRegistry           bool
+	ReadinessProbe             ProbeFlags
+	LivenessProbe              ProbeFlags
+}
+
+type ProbeFlags struct {
+	Probe   string
+	Options string
+	Remove  bool
 }
 
 type ResourceFlags struct {
@@ -41,7 +54,9 @@ type ResourceFlags struct {
 	Memory string
 }
 
-func (p *ConfigurationEditFlags) AddUpdateFlags(command *cobra.Command) {
+// AddTemplateFlags adds the flags which edit the revision template, for
+// commands working on configurations as well as on services
+func (p *ConfigurationEditFlags) AddTemplateFlags(command *cobra.Command) {
 	command.Flags().StringVar(&p.Image, "image", "", "Image to run.")
 	command.Flags().StringArrayVarP(&p.Env, "env", "e", []string{},
 		"Environment variable to set. NAME=value; you may provide this flag "+
@@ -54,6 +69,26 @@ func (p *ConfigurationEditFlags) AddUpdateFlags(command *cobra.Command) {
 	command.Flags().IntVar(&p.MaxScale, "max-scale", 0, "Maximal number of replicas.")
 	command.Flags().IntVar(&p.ConcurrencyTarget, "concurrency-target", 0, "Recommendation for when to scale up based on the concurrent number of incoming request. Defaults to --concurrency-limit when given.")
 	command.Flags().IntVar(&p.ConcurrencyLimit, "concurrency-limit", 0, "Hard Limit of concurrent requests to be processed by a single replica.")
+	command.Flags().BoolVar(&p.LockToDigest, "lock-to-digest", false, "Resolve the image to its digest and run the image by digest. The original image is kept in an annotation.")
+	command.Flags().BoolVar(&p.InsecureRegistry, "insecure-registry", false, "Access the image registry via plain HTTP when resolving the digest with --lock-to-digest.")
+	p.ReadinessProbe.addFlags(command, servinglib.ReadinessProbe)
+	p.LivenessProbe.addFlags(command, servinglib.LivenessProbe)
+}
+
+func (p *ConfigurationEditFlags) AddUpdateFlags(command *cobra.Command) {
+	p.AddTemplateFlags(command)
+	command.Flags().BoolVar(&p.ClusterLocal, "cluster-local", false, "Make the service reachable only from within the cluster.")
+	command.Flags().BoolVar(&p.NoClusterLocal, "no-cluster-local", false, "Make the service reachable from outside the cluster.")
+}
+
+func (p *ProbeFlags) addFlags(command *cobra.Command, kind servinglib.ProbeKind) {
+	command.Flags().StringVar(&p.Probe, string(kind)+"-probe", "",
+		"Probe to check the "+string(kind)+" of the container. 'http:PATH[:PORT]', 'tcp[:PORT]' or 'exec:CMD'; "+
+			"PORT has to be the container port.")
+	command.Flags().StringVar(&p.Options, string(kind)+"-probe-options", "",
+		"Timing of the "+string(kind)+" probe. Comma separated KEY=VALUE pairs with the keys "+
+			"initial-delay, period, timeout (e.g. 10s), success-threshold and failure-threshold.")
+	command.Flags().BoolVar(&p.Remove, "no-"+string(kind)+"-probe", false, "Remove the "+string(kind)+" probe.")
 }
 
 func (p *ConfigurationEditFlags) AddCreateFlags(command *cobra.Command) {
@@ -63,31 +98,83 @@ func (p *ConfigurationEditFlags) AddCreateFlags(command *cobra.Command) {
 }
 
 func (p *ConfigurationEditFlags) Apply(service *servingv1alpha1.Service, cmd *cobra.Command) error {
+	err := p.ApplyWithoutDigest(service, cmd)
+	if err != nil {
+		return err
+	}
+	return p.ResolveDigest(service, cmd)
+}
+
+// ApplyWithoutDigest applies and validates all flags, but doesn't resolve
+// the image's digest for --lock-to-digest yet. This allows to report bad
+// input before building the image, which has to exist for ResolveDigest.
+func (p *ConfigurationEditFlags) ApplyWithoutDigest(service *servingv1alpha1.Service, cmd *cobra.Command) error {
+	if p.ClusterLocal && p.NoClusterLocal {
+		return errors.New("only one of --cluster-local and --no-cluster-local can be given.")
+	}
+	if p.ClusterLocal {
+		servinglib.UpdateClusterLocal(service, true)
+	}
+	if p.NoClusterLocal {
+		servinglib.UpdateClusterLocal(service, false)
+	}
 
 	template, err := servinglib.GetRevisionTemplate(service)
 	if err != nil {
 		return err
 	}
+	return p.applyTemplate(template, cmd)
+}
 
+// ResolveDigest locks the service's image to its digest if --lock-to-digest
+// is given
+func (p *ConfigurationEditFlags) ResolveDigest(service *servingv1alpha1.Service, cmd *cobra.Command) error {
+	if !p.LockToDigest {
+		return nil
+	}
+	template, err := servinglib.GetRevisionTemplate(service)
+	if err != nil {
+		return err
+	}
+	return p.lockToDigest(template, cmd)
+}
+
+// ApplyTemplate applies the flags added with AddTemplateFlags to the given
+// revision template
+func (p *ConfigurationEditFlags) ApplyTemplate(template *servingv1alpha1.RevisionTemplateSpec, cmd *cobra.Command) error {
+	err := p.applyTemplate(template, cmd)
+	if err != nil {
+		return err
+	}
+	if p.LockToDigest {
+		return p.lockToDigest(template, cmd)
+	}
+	return nil
+}
+
+// applyTemplate applies all template flags except for --lock-to-digest
+func (p *ConfigurationEditFlags) applyTemplate(template *servingv1alpha1.RevisionTemplateSpec, cmd *cobra.Command) error {
 	envMap := map[string]string{}
 	for _, pairStr := range p.Env {
-		pairSlice := strings.SplitN(pairStr, "=", 2)
-		if len(pairSlice) <= 1 {
-			return fmt.Errorf(
-				"--env argument requires a value that contains the '=' character; got %s",
-				pairStr)
+		name, value, err := commands.SplitKeyValue("env", pairStr)
+		if err != nil {
+			return err
 		}
-		envMap[pairSlice[0]] = pairSlice[1]
+		envMap[name] = value
 	}
 	if err := servinglib.UpdateEnvVars(template, envMap); err != nil {
 		return err
 	}
 
-	if cmd.Flags().Changed("image") {
+	var err error
+	// with --lock-to-digest the image is set once its digest is resolved
+	if !p.LockToDigest && cmd.Flags().Changed("image") {
 		err = servinglib.UpdateImage(template, p.Image)
 		if err != nil {
 			return err
 		}
+		// a digest locked earlier doesn't belong to the new image
+		delete(template.Annotations, servinglib.UserImageAnnotationKey)
 	}
 	limitsResources, err := p.computeResources(p.LimitsFlags)
 	if err != nil {
@@ -104,9 +191,78 @@ func (p *ConfigurationEditFlags) Apply(service *servingv1alpha1.Service, cmd *co
 
 	servinglib.UpdateConcurrencyConfiguration(template, p.MinScale, p.MaxScale, p.ConcurrencyTarget, p.ConcurrencyLimit)
 
+	err = p.ReadinessProbe.apply(template, servinglib.ReadinessProbe)
+	if err != nil {
+		return err
+	}
+	err = p.LivenessProbe.apply(template, servinglib.LivenessProbe)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// apply sets, updates or removes the probe of the given kind. Options
+// given without a probe update the timing of the existing probe.
+func (p *ProbeFlags) apply(template *servingv1alpha1.RevisionTemplateSpec, kind servinglib.ProbeKind) error {
+	if p.Remove {
+		if p.Probe != "" || p.Options != "" {
+			return fmt.Errorf("--no-%s-probe can't be combined with --%s-probe or --%s-probe-options.", kind, kind, kind)
+		}
+		return servinglib.UpdateProbe(template, kind, nil)
+	}
+	if p.Probe == "" && p.Options == "" {
+		return nil
+	}
+
+	var probe *corev1.Probe
+	if p.Probe != "" {
+		port, err := servinglib.GetContainerPort(template)
+		if err != nil {
+			return err
+		}
+		probe, err = servinglib.ParseProbe(p.Probe, port)
+		if err != nil {
+			return err
+		}
+	} else {
+		existing, err := servinglib.GetProbe(template, kind)
+		if err != nil {
+			return err
+		}
+		if existing == nil {
+			return fmt.Errorf("requires --%s-probe as no %s probe is set yet.", kind, kind)
+		}
+		probe = existing.DeepCopy()
+	}
+	if p.Options != "" {
+		err := servinglib.UpdateProbeOptions(probe, p.Options)
+		if err != nil {
+			return err
+		}
+	}
+	return servinglib.UpdateProbe(template, kind, probe)
+}
+
+// lockToDigest resolves the image given with --image, or the image the
+// service has been created with, to a digest
+func (p *ConfigurationEditFlags) lockToDigest(template *servingv1alpha1.RevisionTemplateSpec, cmd *cobra.Command) error {
+	image := p.Image
+	if !cmd.Flags().Changed("image") {
+		var err error
+		image, err = servinglib.GetUserImage(template)
+		if err != nil {
+			return err
+		}
+	}
+	digest, err := servinglib.ResolveImageDigest(image, p.InsecureRegistry)
+	if err != nil {
+		return err
+	}
+	return servinglib.UpdateImageWithDigest(template, image, digest)
+}
+
 func (p *ConfigurationEditFlags) computeResources(resourceFlags ResourceFlags) (corev1.ResourceList, error) {
 	resourceList := corev1.ResourceList{}
 
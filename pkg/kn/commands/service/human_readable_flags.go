@@ -17,6 +17,7 @@ package service
 import (
 	"github.com/knative/client/pkg/kn/commands"
 	hprinters "github.com/knative/client/pkg/printers"
+	servinglib "github.com/knative/client/pkg/serving"
 	servingv1alpha1 "github.com/knative/serving/pkg/apis/serving/v1alpha1"
 	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -27,13 +28,16 @@ func ServiceGetHandlers(h hprinters.PrintHandler) {
 	kServiceColumnDefinitions := []metav1beta1.TableColumnDefinition{
 		{Name: "Name", Type: "string", Description: "Name of the knative service."},
 		{Name: "Domain", Type: "string", Description: "Domain name of the knative service."},
-		//{Name: "LastCreatedRevision", Type: "string", Description: "Name of last revision created."},
-		//{Name: "LastReadyRevision", Type: "string", Description: "Name of last ready revision."},
+		{Name: "URL", Type: "string", Description: "URL of the knative service.", Priority: 1},
+		{Name: "LatestCreated", Type: "string", Description: "Name of last revision created.", Priority: 1},
+		{Name: "LatestReady", Type: "string", Description: "Name of last ready revision.", Priority: 1},
 		{Name: "Generation", Type: "integer", Description: "Sequence number of 'Generation' of the service that was last processed by the controller."},
 		{Name: "Age", Type: "string", Description: "Age of the service."},
 		{Name: "Conditions", Type: "string", Description: "Conditions describing statuses of service components."},
 		{Name: "Ready", Type: "string", Description: "Ready condition status of the service."},
 		{Name: "Reason", Type: "string", Description: "Reason for non-ready condition of the service."},
+		{Name: "Image", Type: "string", Description: "Image of the service's revision template.", Priority: 1},
+		{Name: "Concurrency", Type: "string", Description: "Concurrency limit and target of the service's revision template.", Priority: 1},
 	}
 	h.TableHandler(kServiceColumnDefinitions, printKService)
 	h.TableHandler(kServiceColumnDefinitions, printKServiceList)
@@ -58,13 +62,28 @@ func printKServiceList(kServiceList *servingv1alpha1.ServiceList, options hprint
 func printKService(kService *servingv1alpha1.Service, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
 	name := kService.Name
 	domain := kService.Status.RouteStatusFields.DeprecatedDomain
-	//lastCreatedRevision := kService.Status.LatestCreatedRevisionName
-	//lastReadyRevision := kService.Status.LatestReadyRevisionName
+	if servinglib.IsClusterLocal(kService) {
+		// cluster-local services have no external domain
+		domain, _ = servinglib.GetServiceInternalHost(kService)
+	}
+	url := ""
+	if kService.Status.URL != nil {
+		url = kService.Status.URL.String()
+	}
+	lastCreatedRevision := kService.Status.LatestCreatedRevisionName
+	lastReadyRevision := kService.Status.LatestReadyRevisionName
 	generation := kService.Status.ObservedGeneration
 	age := commands.TranslateTimestampSince(kService.CreationTimestamp)
 	conditions := commands.ConditionsValue(kService.Status.Conditions)
 	ready := commands.ReadyCondition(kService.Status.Conditions)
 	reason := commands.NonReadyConditionReason(kService.Status.Conditions)
+	image, concurrency := "", ""
+	if options.Wide {
+		if template, err := servinglib.GetRevisionTemplate(kService); err == nil && template != nil {
+			image, _ = servinglib.GetUserImage(template)
+			concurrency = servinglib.ConcurrencyValue(template)
+		}
+	}
 
 	row := metav1beta1.TableRow{
 		Object: runtime.RawExtension{Object: kService},
@@ -72,12 +91,15 @@ func printKService(kService *servingv1alpha1.Service, options hprinters.PrintOpt
 	row.Cells = append(row.Cells,
 		name,
 		domain,
-		//lastCreatedRevision,
-		//lastReadyRevision,
+		url,
+		lastCreatedRevision,
+		lastReadyRevision,
 		generation,
 		age,
 		conditions,
 		ready,
-		reason)
+		reason,
+		image,
+		concurrency)
 	return []metav1beta1.TableRow{row}, nil
 }
@@ -29,5 +29,9 @@ func NewServiceCommand(p *commands.KnParams) *cobra.Command {
 	serviceCmd.AddCommand(NewServiceCreateCommand(p))
 	serviceCmd.AddCommand(NewServiceDeleteCommand(p))
 	serviceCmd.AddCommand(NewServiceUpdateCommand(p))
+	serviceCmd.AddCommand(NewServiceInvokeCommand(p))
+	serviceCmd.AddCommand(NewServiceCopyCommand(p))
+	serviceCmd.AddCommand(NewServiceEventsCommand(p))
+	serviceCmd.AddCommand(NewServiceTreeCommand(p))
 	return serviceCmd
 }
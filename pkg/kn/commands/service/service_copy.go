@@ -0,0 +1,115 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/knative/client/pkg/kn/commands"
+	servinglib "github.com/knative/client/pkg/serving"
+	"github.com/spf13/cobra"
+	api_errors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NewServiceCopyCommand represents 'kn service copy' command
+func NewServiceCopyCommand(p *commands.KnParams) *cobra.Command {
+	var editFlags ConfigurationEditFlags
+	var toNamespace, toName, toContext string
+
+	serviceCopyCommand := &cobra.Command{
+		Use:   "copy NAME",
+		Short: "Copy a service to another namespace or under another name.",
+		Example: `
+  # Copy service 'svc1' from namespace 'staging' to namespace 'prod'
+  kn service copy svc1 -n staging --to-namespace prod
+
+  # Copy service 'svc1' to 'svc2' in the same namespace, using another image
+  kn service copy svc1 --name svc2 --image dev.local/ns/image:v2
+
+  # Copy service 'svc1' to the cluster of kubeconfig context 'prod-cluster'
+  kn service copy svc1 --to-context prod-cluster`,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("requires the service name.")
+			}
+			namespace, err := commands.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			targetNamespace := toNamespace
+			if targetNamespace == "" {
+				targetNamespace = namespace
+			}
+			targetName := toName
+			if targetName == "" {
+				targetName = args[0]
+			}
+			if toContext == "" && targetNamespace == namespace && targetName == args[0] {
+				return errors.New("requires a different namespace, name or context for the copy.")
+			}
+
+			client, err := p.ServingFactory()
+			if err != nil {
+				return err
+			}
+			source, err := client.Services(namespace).Get(args[0], v1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			service, err := servinglib.CopyService(source, targetNamespace, targetName)
+			if err != nil {
+				return err
+			}
+			err = editFlags.Apply(service, cmd)
+			if err != nil {
+				return err
+			}
+
+			targetClient := client
+			if toContext != "" {
+				targetClient, err = p.ContextServingFactory(toContext)
+				if err != nil {
+					return err
+				}
+			}
+
+			existingService, err := targetClient.Services(targetNamespace).Get(targetName, v1.GetOptions{})
+			switch {
+			case err == nil:
+				service.ResourceVersion = existingService.ResourceVersion
+				_, err = targetClient.Services(targetNamespace).Update(service)
+			case api_errors.IsNotFound(err):
+				_, err = targetClient.Services(targetNamespace).Create(service)
+			}
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Service '%s' successfully copied to '%s' in namespace '%s'.\n", args[0], targetName, targetNamespace)
+			if servinglib.HasRevisionTraffic(source) {
+				fmt.Fprintf(cmd.OutOrStdout(), "Traffic split and tags of '%s' have not been copied, all traffic of '%s' is routed to its latest revision.\n", args[0], targetName)
+			}
+			return nil
+		},
+	}
+	commands.AddNamespaceFlags(serviceCopyCommand.Flags(), false)
+	serviceCopyCommand.Flags().StringVar(&toNamespace, "to-namespace", "", "Namespace to copy the service to. Defaults to the namespace of the service.")
+	serviceCopyCommand.Flags().StringVar(&toName, "name", "", "Name of the copy. Defaults to the name of the service.")
+	serviceCopyCommand.Flags().StringVar(&toContext, "to-context", "", "Kubeconfig context of the cluster to copy the service to. Defaults to the current context.")
+	editFlags.AddUpdateFlags(serviceCopyCommand)
+	return serviceCopyCommand
+}
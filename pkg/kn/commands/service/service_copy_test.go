@@ -0,0 +1,188 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/knative/client/pkg/kn/commands"
+	servinglib "github.com/knative/client/pkg/serving"
+	"github.com/knative/serving/pkg/apis/serving"
+	"github.com/knative/serving/pkg/apis/serving/v1alpha1"
+	"github.com/knative/serving/pkg/apis/serving/v1beta1"
+	servingclient "github.com/knative/serving/pkg/client/clientset/versioned/typed/serving/v1alpha1"
+	"github.com/knative/serving/pkg/client/clientset/versioned/typed/serving/v1alpha1/fake"
+	api_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	client_testing "k8s.io/client-go/testing"
+)
+
+// fakeServiceCopy runs the copy command against a source cluster containing
+// the given service and a target cluster containing the given target service, if any
+func fakeServiceCopy(args []string, source *v1alpha1.Service, target *v1alpha1.Service) (
+	action client_testing.Action,
+	copied *v1alpha1.Service,
+	context string,
+	output string,
+	err error) {
+	knParams := &commands.KnParams{}
+	cmd, fakeServing, buf := commands.CreateTestKnCommand(NewServiceCommand(knParams), knParams)
+	fakeTarget := &fake.FakeServingV1alpha1{Fake: &client_testing.Fake{}}
+	knParams.ContextServingFactory = func(ctx string) (servingclient.ServingV1alpha1Interface, error) {
+		context = ctx
+		return fakeTarget, nil
+	}
+
+	fakeServing.AddReactor("get", "services",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			getAction := a.(client_testing.GetAction)
+			if getAction.GetNamespace() == source.Namespace && getAction.GetName() == source.Name {
+				return true, source, nil
+			}
+			return false, nil, nil
+		})
+	for _, f := range []*fake.FakeServingV1alpha1{fakeServing, fakeTarget} {
+		f.AddReactor("get", "services",
+			func(a client_testing.Action) (bool, runtime.Object, error) {
+				if target != nil {
+					return true, target, nil
+				}
+				return true, nil, api_errors.NewNotFound(v1alpha1.Resource("services"), a.(client_testing.GetAction).GetName())
+			})
+		f.AddReactor("*", "services",
+			func(a client_testing.Action) (bool, runtime.Object, error) {
+				action = a
+				copied = a.(client_testing.CreateAction).GetObject().(*v1alpha1.Service)
+				return true, copied, nil
+			})
+	}
+	cmd.SetArgs(args)
+	err = cmd.Execute()
+	output = buf.String()
+	return
+}
+
+func newSourceService() *v1alpha1.Service {
+	service := newEmptyService()
+	service.Namespace = "staging"
+	service.ResourceVersion = "42"
+	service.UID = "abcd"
+	service.Annotations = map[string]string{serving.CreatorAnnotation: "someone", "team": "a"}
+	service.Status.DeprecatedDomain = "foo.staging.example.com"
+	template, _ := servinglib.GetRevisionTemplate(service)
+	template.Name = "foo-v1"
+	servinglib.UpdateImage(template, "gcr.io/foo/bar:v1")
+	return service
+}
+
+func TestServiceCopyToNamespace(t *testing.T) {
+	action, copied, _, output, err := fakeServiceCopy([]string{
+		"service", "copy", "foo", "-n", "staging", "--to-namespace", "prod"}, newSourceService(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !action.Matches("create", "services") {
+		t.Fatalf("Bad action %v", action)
+	}
+	if copied.Namespace != "prod" || copied.Name != "foo" {
+		t.Fatalf("wrong target %s/%s", copied.Namespace, copied.Name)
+	}
+	if copied.ResourceVersion != "" || copied.UID != "" || copied.Status.DeprecatedDomain != "" {
+		t.Fatalf("identity or status not stripped: %v", copied)
+	}
+	if _, present := copied.Annotations[serving.CreatorAnnotation]; present || copied.Annotations["team"] != "a" {
+		t.Fatalf("wrong annotations: %v", copied.Annotations)
+	}
+	template, err := servinglib.GetRevisionTemplate(copied)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if template.Name != "" || template.Spec.DeprecatedContainer.Image != "gcr.io/foo/bar:v1" {
+		t.Fatalf("wrong template: %v", template)
+	}
+	if !strings.Contains(output, "prod") {
+		t.Fatalf("wrong output: %s", output)
+	}
+}
+
+func TestServiceCopyOverrideImage(t *testing.T) {
+	action, copied, _, _, err := fakeServiceCopy([]string{
+		"service", "copy", "foo", "-n", "staging", "--name", "bar", "--image", "gcr.io/foo/bar:v2"},
+		newSourceService(), newEmptyService())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !action.Matches("update", "services") {
+		t.Fatalf("Bad action %v", action)
+	}
+	template, err := servinglib.GetRevisionTemplate(copied)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if copied.Name != "bar" || copied.Namespace != "staging" || template.Spec.DeprecatedContainer.Image != "gcr.io/foo/bar:v2" {
+		t.Fatalf("wrong copy %s/%s with image %s", copied.Namespace, copied.Name, template.Spec.DeprecatedContainer.Image)
+	}
+}
+
+func TestServiceCopyToContext(t *testing.T) {
+	action, copied, context, _, err := fakeServiceCopy([]string{
+		"service", "copy", "foo", "-n", "staging", "--to-context", "prod-cluster"}, newSourceService(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if context != "prod-cluster" || !action.Matches("create", "services") {
+		t.Fatalf("service not created in context prod-cluster: %s %v", context, action)
+	}
+	if copied.Namespace != "staging" || copied.Name != "foo" {
+		t.Fatalf("wrong target %s/%s", copied.Namespace, copied.Name)
+	}
+}
+
+func TestServiceCopyTrafficNotice(t *testing.T) {
+	source := newSourceService()
+	_, _, _, output, err := fakeServiceCopy([]string{
+		"service", "copy", "foo", "-n", "staging", "--to-namespace", "prod"}, source, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(output, "Traffic") {
+		t.Fatalf("unexpected traffic notice: %s", output)
+	}
+
+	source.Spec.Traffic = []v1alpha1.TrafficTarget{
+		{TrafficTarget: v1beta1.TrafficTarget{RevisionName: "foo-v1", Tag: "stable", Percent: 100}},
+	}
+	_, copied, _, output, err := fakeServiceCopy([]string{
+		"service", "copy", "foo", "-n", "staging", "--to-namespace", "prod"}, source, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if copied.Spec.Traffic != nil {
+		t.Fatalf("traffic copied: %v", copied.Spec.Traffic)
+	}
+	expected := "Traffic split and tags of 'foo' have not been copied"
+	if !strings.Contains(output, expected) {
+		t.Fatalf("expected '%s' in output: %s", expected, output)
+	}
+}
+
+func TestServiceCopySameTarget(t *testing.T) {
+	_, _, _, _, err := fakeServiceCopy([]string{
+		"service", "copy", "foo", "-n", "staging"}, newSourceService(), nil)
+	if err == nil {
+		t.Fatal("expected error when copying a service onto itself")
+	}
+}
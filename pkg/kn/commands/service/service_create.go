@@ -28,6 +28,7 @@ import (
 
 func NewServiceCreateCommand(p *commands.KnParams) *cobra.Command {
 	var editFlags ConfigurationEditFlags
+	var sourceFlags SourceFlags
 
 	serviceCreateCommand := &cobra.Command{
 		Use:   "create NAME --image IMAGE",
@@ -49,7 +50,14 @@ func NewServiceCreateCommand(p *commands.KnParams) *cobra.Command {
   # Create or replace default resources of a service 's1' using --force flag
   # (earlier configured resource requests and limits will be replaced with default)
   # (earlier configured environment variables will be cleared too if any)
-  kn service create --force s1 --image dev.local/ns/image:v1`,
+  kn service create --force s1 --image dev.local/ns/image:v1
+
+  # Create a service which is ready once '/ready' responds, checking every 5 seconds
+  kn service create mysvc --image dev.local/ns/image:latest --readiness-probe http:/ready --readiness-probe-options period=5s
+
+  # Build the image from a git repository with the build template 'kaniko', push it
+  # to docker.io/myorg/app and create service 'mysvc' running it
+  kn service create mysvc --source https://github.com/myorg/app --build-template kaniko --image docker.io/myorg/app`,
 
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			if len(args) != 1 {
@@ -58,6 +66,9 @@ func NewServiceCreateCommand(p *commands.KnParams) *cobra.Command {
 			if editFlags.Image == "" {
 				return errors.New("requires the image name to run.")
 			}
+			if sourceFlags.Source == "" && sourceFlags.BuildTemplate != "" {
+				return errors.New("requires --source when using a build template.")
+			}
 
 			namespace, err := commands.GetNamespace(cmd)
 			if err != nil {
@@ -81,7 +92,22 @@ func NewServiceCreateCommand(p *commands.KnParams) *cobra.Command {
 				},
 			}
 
-			err = editFlags.Apply(&service, cmd)
+			err = editFlags.ApplyWithoutDigest(&service, cmd)
+			if err != nil {
+				return err
+			}
+			// the image has to be built before --lock-to-digest can resolve its digest
+			if sourceFlags.Source != "" {
+				dynamicClient, err := p.DynamicFactory()
+				if err != nil {
+					return err
+				}
+				err = sourceFlags.Build(dynamicClient, namespace, args[0], editFlags.Image, cmd.OutOrStdout())
+				if err != nil {
+					return err
+				}
+			}
+			err = editFlags.ResolveDigest(&service, cmd)
 			if err != nil {
 				return err
 			}
@@ -114,5 +140,6 @@ func NewServiceCreateCommand(p *commands.KnParams) *cobra.Command {
 	}
 	commands.AddNamespaceFlags(serviceCreateCommand.Flags(), false)
 	editFlags.AddCreateFlags(serviceCreateCommand)
+	sourceFlags.AddFlags(serviceCreateCommand)
 	return serviceCreateCommand
 }
@@ -17,16 +17,23 @@ package service
 import (
 	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"strings"
 	"testing"
 
+	buildv1alpha1 "github.com/knative/build/pkg/apis/build/v1alpha1"
+	buildlib "github.com/knative/client/pkg/build"
 	"github.com/knative/client/pkg/kn/commands"
 	servinglib "github.com/knative/client/pkg/serving"
+	duckv1alpha1 "github.com/knative/pkg/apis/duck/v1alpha1"
 	"github.com/knative/serving/pkg/apis/serving/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
 	client_testing "k8s.io/client-go/testing"
 )
 
@@ -312,6 +319,81 @@ func TestServiceCreateRequestsLimitsCPUMemory(t *testing.T) {
 	}
 }
 
+func TestServiceCreateClusterLocal(t *testing.T) {
+	_, created, _, err := fakeServiceCreate([]string{
+		"service", "create", "foo", "--image", "gcr.io/foo/bar:baz", "--cluster-local"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !servinglib.IsClusterLocal(created) {
+		t.Fatalf("visibility label not set: %v", created.Labels)
+	}
+}
+
+func TestServiceCreateProbes(t *testing.T) {
+	_, created, _, err := fakeServiceCreate([]string{
+		"service", "create", "foo", "--image", "gcr.io/foo/bar:baz",
+		"--readiness-probe", "http:/ready:8080", "--readiness-probe-options", "initial-delay=5,period=10s",
+		"--liveness-probe", "exec:cat /tmp/healthy"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	template, err := servinglib.GetRevisionTemplate(created)
+	if err != nil {
+		t.Fatal(err)
+	}
+	container := template.Spec.DeprecatedContainer
+	expectedReadiness := &corev1.Probe{
+		Handler:             corev1.Handler{HTTPGet: &corev1.HTTPGetAction{Path: "/ready"}},
+		InitialDelaySeconds: 5,
+		PeriodSeconds:       10,
+	}
+	if !reflect.DeepEqual(container.ReadinessProbe, expectedReadiness) {
+		t.Fatalf("wrong readiness probe %v", container.ReadinessProbe)
+	}
+	expectedLiveness := &corev1.Probe{
+		Handler: corev1.Handler{Exec: &corev1.ExecAction{Command: []string{"cat", "/tmp/healthy"}}},
+	}
+	if !reflect.DeepEqual(container.LivenessProbe, expectedLiveness) {
+		t.Fatalf("wrong liveness probe %v", container.LivenessProbe)
+	}
+}
+
+func TestServiceCreateProbeWrongPort(t *testing.T) {
+	_, _, _, err := fakeServiceCreate([]string{
+		"service", "create", "foo", "--image", "gcr.io/foo/bar:baz", "--readiness-probe", "tcp:9090"})
+	if err == nil || !strings.Contains(err.Error(), "container port 8080") {
+		t.Fatalf("expected port error, got %v", err)
+	}
+}
+
+func TestServiceCreateLockToDigest(t *testing.T) {
+	registry := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v2/foo/bar/manifests/baz" {
+			w.Header().Set("Content-Type", "application/vnd.docker.distribution.manifest.v2+json")
+			w.Write([]byte("{}"))
+		}
+	}))
+	defer registry.Close()
+	image := strings.TrimPrefix(registry.URL, "http://") + "/foo/bar:baz"
+
+	_, created, _, err := fakeServiceCreate([]string{
+		"service", "create", "foo", "--image", image, "--lock-to-digest"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	template, err := servinglib.GetRevisionTemplate(created)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(template.Spec.DeprecatedContainer.Image, "/foo/bar@sha256:") {
+		t.Fatalf("image not locked to digest: %s", template.Spec.DeprecatedContainer.Image)
+	}
+	if template.Annotations[servinglib.UserImageAnnotationKey] != image {
+		t.Fatalf("original image not recorded: %v", template.Annotations)
+	}
+}
+
 func parseQuantity(t *testing.T, quantityString string) resource.Quantity {
 	quantity, err := resource.ParseQuantity(quantityString)
 	if err != nil {
@@ -379,3 +461,181 @@ func TestServiceCreateEnvForce(t *testing.T) {
 		t.Fatalf("wrong output: %s", output)
 	}
 }
+
+func fakeServiceCreateFromSource(args []string, buildStatus buildv1alpha1.BuildStatus) (
+	created *v1alpha1.Service,
+	build *buildv1alpha1.Build,
+	output string,
+	err error) {
+	knParams := &commands.KnParams{}
+	cmd, fakeServing, fakeDynamic, buf := commands.CreateTestKnCommandWithDynamic(NewServiceCommand(knParams), knParams)
+	fakeServing.AddReactor("create", "services",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			created = a.(client_testing.CreateAction).GetObject().(*v1alpha1.Service)
+			return true, created, nil
+		})
+	fakeDynamic.PrependReactor("create", "builds",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			obj := a.(client_testing.CreateAction).GetObject().(*unstructured.Unstructured)
+			build, err = buildlib.FromUnstructured(obj)
+			return false, nil, err
+		})
+	fakeDynamic.PrependWatchReactor("builds",
+		func(a client_testing.Action) (bool, watch.Interface, error) {
+			watcher := watch.NewFakeWithChanSize(1, false)
+			finished := build.DeepCopy()
+			finished.Status = buildStatus
+			obj, err := buildlib.ToUnstructured(finished)
+			if err != nil {
+				return true, nil, err
+			}
+			watcher.Modify(obj)
+			return true, watcher, nil
+		})
+	cmd.SetArgs(args)
+	err = cmd.Execute()
+	output = buf.String()
+	return
+}
+
+func newBuildStatus(succeeded corev1.ConditionStatus) buildv1alpha1.BuildStatus {
+	status := buildv1alpha1.BuildStatus{
+		StepStates: []corev1.ContainerState{
+			{Terminated: &corev1.ContainerStateTerminated{ExitCode: 0}},
+		},
+		StepsCompleted: []string{"build-step-git-source"},
+	}
+	status.Conditions = duckv1alpha1.Conditions{{
+		Type:    buildv1alpha1.BuildSucceeded,
+		Status:  succeeded,
+		Reason:  "BuildFailed",
+		Message: "step failed",
+	}}
+	return status
+}
+
+func TestServiceCreateFromSource(t *testing.T) {
+	created, build, output, err := fakeServiceCreateFromSource([]string{
+		"service", "create", "foo", "--image", "gcr.io/foo/bar:baz",
+		"--source", "https://github.com/foo/bar", "--build-template", "kaniko",
+		"--build-arg", "DOCKERFILE=Dockerfile.prod"}, newBuildStatus(corev1.ConditionTrue))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if build.Spec.Source.Git.Url != "https://github.com/foo/bar" || build.Spec.Source.Git.Revision != "master" {
+		t.Fatalf("wrong build source: %v", build.Spec.Source.Git)
+	}
+	expectedArgs := []buildv1alpha1.ArgumentSpec{
+		{Name: "DOCKERFILE", Value: "Dockerfile.prod"},
+		{Name: "IMAGE", Value: "gcr.io/foo/bar:baz"},
+	}
+	if build.Spec.Template.Name != "kaniko" || !reflect.DeepEqual(build.Spec.Template.Arguments, expectedArgs) {
+		t.Fatalf("wrong build template: %v", build.Spec.Template)
+	}
+
+	template, err := servinglib.GetRevisionTemplate(created)
+	if err != nil {
+		t.Fatal(err)
+	} else if template.Spec.DeprecatedContainer.Image != "gcr.io/foo/bar:baz" {
+		t.Fatalf("wrong image set: %v", template.Spec.DeprecatedContainer.Image)
+	}
+	if !strings.Contains(output, "'build-step-git-source': Completed") || !strings.Contains(output, "succeeded") {
+		t.Fatalf("wrong output: %s", output)
+	}
+}
+
+func TestServiceCreateFromSourceBuildFailed(t *testing.T) {
+	created, _, _, err := fakeServiceCreateFromSource([]string{
+		"service", "create", "foo", "--image", "gcr.io/foo/bar:baz",
+		"--source", "https://github.com/foo/bar", "--build-template", "kaniko"}, newBuildStatus(corev1.ConditionFalse))
+	if err == nil || !strings.Contains(err.Error(), "step failed") {
+		t.Fatalf("expected build failure, got %v", err)
+	}
+	if created != nil {
+		t.Fatal("service must not be created when the build fails")
+	}
+}
+
+func TestServiceCreateFromSourceLockToDigest(t *testing.T) {
+	// the registry only knows the image once the build has pushed it
+	var pushed bool
+	registry := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v2/foo/bar/manifests/baz" {
+			if !pushed {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Content-Type", "application/vnd.docker.distribution.manifest.v2+json")
+			w.Write([]byte("{}"))
+		}
+	}))
+	defer registry.Close()
+	image := strings.TrimPrefix(registry.URL, "http://") + "/foo/bar:baz"
+
+	knParams := &commands.KnParams{}
+	cmd, fakeServing, fakeDynamic, _ := commands.CreateTestKnCommandWithDynamic(NewServiceCommand(knParams), knParams)
+	var created *v1alpha1.Service
+	fakeServing.AddReactor("create", "services",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			created = a.(client_testing.CreateAction).GetObject().(*v1alpha1.Service)
+			return true, created, nil
+		})
+	var build *buildv1alpha1.Build
+	fakeDynamic.PrependReactor("create", "builds",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			var err error
+			build, err = buildlib.FromUnstructured(a.(client_testing.CreateAction).GetObject().(*unstructured.Unstructured))
+			return false, nil, err
+		})
+	fakeDynamic.PrependWatchReactor("builds",
+		func(a client_testing.Action) (bool, watch.Interface, error) {
+			watcher := watch.NewFakeWithChanSize(1, false)
+			finished := build.DeepCopy()
+			finished.Status = newBuildStatus(corev1.ConditionTrue)
+			obj, err := buildlib.ToUnstructured(finished)
+			if err != nil {
+				return true, nil, err
+			}
+			pushed = true
+			watcher.Modify(obj)
+			return true, watcher, nil
+		})
+	cmd.SetArgs([]string{"service", "create", "foo", "--image", image, "--lock-to-digest",
+		"--source", "https://github.com/foo/bar", "--build-template", "kaniko"})
+	err := cmd.Execute()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template, err := servinglib.GetRevisionTemplate(created)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(template.Spec.DeprecatedContainer.Image, "/foo/bar@sha256:") {
+		t.Fatalf("image not locked to digest: %s", template.Spec.DeprecatedContainer.Image)
+	}
+	if template.Annotations[servinglib.UserImageAnnotationKey] != image {
+		t.Fatalf("original image not recorded: %v", template.Annotations)
+	}
+}
+
+func TestServiceCreateFromSourceInvalidFlags(t *testing.T) {
+	_, build, _, err := fakeServiceCreateFromSource([]string{
+		"service", "create", "foo", "--image", "gcr.io/foo/bar:baz", "--env", "A",
+		"--source", "https://github.com/foo/bar", "--build-template", "kaniko"}, newBuildStatus(corev1.ConditionTrue))
+	if err == nil || !strings.Contains(err.Error(), "--env") {
+		t.Fatalf("expected error for invalid --env, got %v", err)
+	}
+	if build != nil {
+		t.Fatal("no build must be started for invalid flags")
+	}
+}
+
+func TestServiceCreateBuildTemplateWithoutSource(t *testing.T) {
+	_, _, _, err := fakeServiceCreateFromSource([]string{
+		"service", "create", "foo", "--image", "gcr.io/foo/bar:baz", "--build-template", "kaniko"}, buildv1alpha1.BuildStatus{})
+	if err == nil || !strings.Contains(err.Error(), "--source") {
+		t.Fatalf("expected error for missing --source, got %v", err)
+	}
+}
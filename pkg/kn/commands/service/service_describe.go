@@ -16,11 +16,13 @@ package service
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/knative/client/pkg/kn/commands"
+	servinglib "github.com/knative/client/pkg/serving"
+	servingv1alpha1 "github.com/knative/serving/pkg/apis/serving/v1alpha1"
 	"github.com/spf13/cobra"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 )
 
@@ -51,14 +53,17 @@ func NewServiceDescribeCommand(p *commands.KnParams) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			describeService.GetObjectKind().SetGroupVersionKind(schema.GroupVersionKind{
-				Group:   "knative.dev",
-				Version: "v1alpha1",
-				Kind:    "Service"})
+			describeService.GetObjectKind().SetGroupVersionKind(servingv1alpha1.SchemeGroupVersion.WithKind("Service"))
 			err = printer.PrintObj(describeService, cmd.OutOrStdout())
 			if err != nil {
 				return err
 			}
+			if servinglib.IsClusterLocal(describeService) {
+				// not part of the printed object, so that it can still be parsed
+				if host, err := servinglib.GetServiceInternalHost(describeService); err == nil {
+					fmt.Fprintf(cmd.OutOrStderr(), "Service '%s' is cluster-local and reachable within the cluster at %s.\n", describeService.Name, host)
+				}
+			}
 			return nil
 		},
 	}
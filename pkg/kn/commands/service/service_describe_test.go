@@ -16,9 +16,11 @@ package service
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/knative/client/pkg/kn/commands"
+	servinglib "github.com/knative/client/pkg/serving"
 	"github.com/knative/serving/pkg/apis/serving/v1alpha1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -56,7 +58,7 @@ func TestServiceDescribeDefaultOutput(t *testing.T) {
 	expectedService := v1alpha1.Service{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "Service",
-			APIVersion: "knative.dev/v1alpha1",
+			APIVersion: "serving.knative.dev/v1alpha1",
 		},
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "foo",
@@ -87,3 +89,31 @@ func TestServiceDescribeDefaultOutput(t *testing.T) {
 		t.Fatal("mismatched objects")
 	}
 }
+
+func TestServiceDescribeClusterLocal(t *testing.T) {
+	service := &v1alpha1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "foo",
+			Namespace: "default",
+			Labels:    map[string]string{servinglib.VisibilityLabelKey: servinglib.VisibilityClusterLocal},
+		},
+	}
+	service.Status.DeprecatedDomainInternal = "foo.default.svc.cluster.local"
+	_, output, err := fakeServiceDescribe([]string{"service", "describe", "foo"}, service)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "Service 'foo' is cluster-local and reachable within the cluster at foo.default.svc.cluster.local."
+	if !strings.Contains(output, expected) {
+		t.Errorf("expected '%s' in output:\n%s", expected, output)
+	}
+
+	service.Labels = nil
+	_, output, err = fakeServiceDescribe([]string{"service", "describe", "foo"}, service)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(output, "cluster-local") {
+		t.Errorf("unexpected cluster-local notice for a public service:\n%s", output)
+	}
+}
@@ -0,0 +1,273 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/knative/client/pkg/kn/commands"
+	hprinters "github.com/knative/client/pkg/printers"
+	"github.com/knative/serving/pkg/apis/serving"
+	serving_v1alpha1_client "github.com/knative/serving/pkg/client/clientset/versioned/typed/serving/v1alpha1"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+// NewServiceEventsCommand represents 'kn service events' command
+func NewServiceEventsCommand(p *commands.KnParams) *cobra.Command {
+	var watchEvents bool
+
+	serviceEventsCommand := &cobra.Command{
+		Use:   "events NAME",
+		Short: "Show the events of a service and of the resources it owns.",
+		Example: `
+  # Show the events of service 'svc1', its configuration, route, revisions, deployments and pods
+  kn service events svc1
+
+  # Keep printing events of service 'svc1' as they occur
+  kn service events svc1 --watch`,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("requires the service name.")
+			}
+			namespace, err := commands.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.ServingFactory()
+			if err != nil {
+				return err
+			}
+			coreClient, err := p.CoreFactory()
+			if err != nil {
+				return err
+			}
+			dynamicClient, err := p.DynamicFactory()
+			if err != nil {
+				return err
+			}
+
+			objects, err := collectServiceObjects(client, coreClient, dynamicClient, namespace, args[0])
+			if err != nil {
+				return err
+			}
+			eventList, err := coreClient.Events(namespace).List(v1.ListOptions{})
+			if err != nil {
+				return err
+			}
+			events := objects.filter(eventList.Items)
+			sortEvents(events)
+
+			out := hprinters.GetNewTabWriter(cmd.OutOrStdout())
+			if len(events) == 0 && !watchEvents {
+				fmt.Fprintf(cmd.OutOrStdout(), "No events found for service '%s'.\n", args[0])
+				return nil
+			}
+			fmt.Fprintln(out, "LAST SEEN\tTYPE\tOBJECT\tREASON\tMESSAGE")
+			for _, event := range events {
+				printEvent(out, &event)
+			}
+			out.Flush()
+			if !watchEvents {
+				return nil
+			}
+
+			resourceVersion := eventList.ResourceVersion
+			watchOnce := func() error {
+				watcher, err := coreClient.Events(namespace).Watch(v1.ListOptions{ResourceVersion: resourceVersion})
+				if err != nil {
+					return err
+				}
+				defer watcher.Stop()
+				for watchEvent := range watcher.ResultChan() {
+					if watchEvent.Type == watch.Error {
+						return fmt.Errorf("watch for events failed: %v", watchEvent.Object)
+					}
+					event, ok := watchEvent.Object.(*corev1.Event)
+					if !ok {
+						continue
+					}
+					resourceVersion = event.ResourceVersion
+					if watchEvent.Type == watch.Deleted {
+						continue
+					}
+					if !objects.matches(event.InvolvedObject) && objects.mayBeNew(event.InvolvedObject) {
+						// a new revision may be rolling out, look out for its resources too
+						objects, err = collectServiceObjects(client, coreClient, dynamicClient, namespace, args[0])
+						if err != nil {
+							return err
+						}
+					}
+					if objects.matches(event.InvolvedObject) {
+						printEvent(out, event)
+						out.Flush()
+					}
+				}
+				return nil
+			}
+			for {
+				// the server closes watches after a timeout, so watch again
+				// starting after the last event seen
+				err = watchOnce()
+				if err != nil {
+					return err
+				}
+			}
+		},
+	}
+	commands.AddNamespaceFlags(serviceEventsCommand.Flags(), false)
+	serviceEventsCommand.Flags().BoolVarP(&watchEvents, "watch", "w", false, "After listing the events, watch for new events.")
+	return serviceEventsCommand
+}
+
+// serviceObjects holds the names of the resources belonging to a service,
+// by kind
+type serviceObjects map[string]map[string]bool
+
+func (o serviceObjects) add(kind, name string) {
+	if o[kind] == nil {
+		o[kind] = map[string]bool{}
+	}
+	o[kind][name] = true
+}
+
+func (o serviceObjects) matches(ref corev1.ObjectReference) bool {
+	return o[ref.Kind][ref.Name]
+}
+
+// mayBeNew tells whether an unknown resource may have been created for the
+// service after its resources have been collected. Revisions are prefixed
+// with the name of their configuration, and so are the names of their
+// deployments, ReplicaSets and pods.
+func (o serviceObjects) mayBeNew(ref corev1.ObjectReference) bool {
+	switch ref.Kind {
+	case "Revision", "Deployment", "ReplicaSet", "Pod":
+		for configuration := range o["Configuration"] {
+			if strings.HasPrefix(ref.Name, configuration+"-") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (o serviceObjects) filter(events []corev1.Event) []corev1.Event {
+	var filtered []corev1.Event
+	for _, event := range events {
+		if o.matches(event.InvolvedObject) {
+			filtered = append(filtered, event)
+		}
+	}
+	return filtered
+}
+
+// collectServiceObjects finds the configurations and routes of a service
+// as well as the revisions of its configurations through their labels.
+// Deployments are found through their revision's label and their owner
+// references, the ReplicaSets through the owner references of the pods.
+func collectServiceObjects(client serving_v1alpha1_client.ServingV1alpha1Interface, coreClient corev1client.CoreV1Interface, dynamicClient dynamic.Interface, namespace, name string) (serviceObjects, error) {
+	service, err := client.Services(namespace).Get(name, v1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	objects := serviceObjects{}
+	objects.add("Service", service.Name)
+
+	serviceSelector := v1.ListOptions{LabelSelector: serving.ServiceLabelKey + "=" + name}
+	routes, err := client.Routes(namespace).List(serviceSelector)
+	if err != nil {
+		return nil, err
+	}
+	for _, route := range routes.Items {
+		objects.add("Route", route.Name)
+	}
+	configurations, err := client.Configurations(namespace).List(serviceSelector)
+	if err != nil {
+		return nil, err
+	}
+	for _, configuration := range configurations.Items {
+		objects.add("Configuration", configuration.Name)
+
+		configurationSelector := v1.ListOptions{LabelSelector: serving.ConfigurationLabelKey + "=" + configuration.Name}
+		revisions, err := client.Revisions(namespace).List(configurationSelector)
+		if err != nil {
+			return nil, err
+		}
+		for i := range revisions.Items {
+			revision := &revisions.Items[i]
+			objects.add("Revision", revision.Name)
+			deployments, err := listOwnedByRevision(dynamicClient, deploymentResource, namespace, revision)
+			if err != nil {
+				return nil, err
+			}
+			for _, deployment := range deployments {
+				objects.add("Deployment", deployment.GetName())
+			}
+		}
+		pods, err := coreClient.Pods(namespace).List(configurationSelector)
+		if err != nil {
+			return nil, err
+		}
+		for _, pod := range pods.Items {
+			objects.add("Pod", pod.Name)
+			for _, owner := range pod.OwnerReferences {
+				objects.add(owner.Kind, owner.Name)
+			}
+		}
+	}
+	return objects, nil
+}
+
+// sortEvents orders events by the time they were last seen, oldest first
+func sortEvents(events []corev1.Event) {
+	sort.SliceStable(events, func(i, j int) bool {
+		return eventTime(&events[i]).Before(eventTime(&events[j]))
+	})
+}
+
+func eventTime(event *corev1.Event) *v1.Time {
+	switch {
+	case !event.LastTimestamp.IsZero():
+		return &event.LastTimestamp
+	case !event.EventTime.IsZero():
+		return &v1.Time{Time: event.EventTime.Time}
+	case !event.FirstTimestamp.IsZero():
+		return &event.FirstTimestamp
+	}
+	return &event.CreationTimestamp
+}
+
+func printEvent(out io.Writer, event *corev1.Event) {
+	message := strings.TrimSpace(event.Message)
+	if event.Count > 1 {
+		message = fmt.Sprintf("%s (x%d)", message, event.Count)
+	}
+	fmt.Fprintf(out, "%s\t%s\t%s/%s\t%s\t%s\n",
+		commands.TranslateTimestampSince(*eventTime(event)),
+		event.Type,
+		strings.ToLower(event.InvolvedObject.Kind),
+		event.InvolvedObject.Name,
+		event.Reason,
+		message)
+}
@@ -0,0 +1,208 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/knative/serving/pkg/apis/serving"
+	"github.com/knative/serving/pkg/apis/serving/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	client_testing "k8s.io/client-go/testing"
+)
+
+func fakeServiceEvents(args []string, events []corev1.Event, watched []corev1.Event) (output string, collected int, err error) {
+	knParams := &commands.KnParams{}
+	cmd, fakeServing, fakeCore, buf := commands.CreateTestKnCommandWithCore(NewServiceCommand(knParams), knParams)
+	fakeDynamic := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+	knParams.DynamicFactory = func() (dynamic.Interface, error) { return fakeDynamic, nil }
+	fakeServing.AddReactor("get", "services",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			collected++
+			return true, createMockServiceWithParams("foo", "foo.default.example.com", 1), nil
+		})
+	fakeServing.AddReactor("list", "routes",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			route := v1alpha1.Route{ObjectMeta: metav1.ObjectMeta{
+				Name:   "foo",
+				Labels: map[string]string{serving.ServiceLabelKey: "foo"},
+			}}
+			return true, &v1alpha1.RouteList{Items: []v1alpha1.Route{route}}, nil
+		})
+	fakeServing.AddReactor("list", "configurations",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			configuration := v1alpha1.Configuration{ObjectMeta: metav1.ObjectMeta{
+				Name:   "foo",
+				Labels: map[string]string{serving.ServiceLabelKey: "foo"},
+			}}
+			return true, &v1alpha1.ConfigurationList{Items: []v1alpha1.Configuration{configuration}}, nil
+		})
+	fakeServing.AddReactor("list", "revisions",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			revision := v1alpha1.Revision{ObjectMeta: metav1.ObjectMeta{
+				Name:   "foo-00001",
+				Labels: map[string]string{serving.ConfigurationLabelKey: "foo"},
+			}}
+			return true, &v1alpha1.RevisionList{Items: []v1alpha1.Revision{revision}}, nil
+		})
+	fakeDynamic.PrependReactor("list", "deployments",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			other := ownedByRevision("Deployment", "foo-00001-other")
+			other.SetOwnerReferences(nil)
+			return true, &unstructured.UnstructuredList{Items: []unstructured.Unstructured{
+				ownedByRevision("Deployment", "foo-00001-deployment"), other,
+			}}, nil
+		})
+	fakeCore.AddReactor("list", "pods",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			pod := corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+				Name:   "foo-00001-deployment-5d8f-x7k2p",
+				Labels: map[string]string{serving.ConfigurationLabelKey: "foo"},
+				OwnerReferences: []metav1.OwnerReference{
+					{Kind: "ReplicaSet", Name: "foo-00001-deployment-5d8f"},
+				},
+			}}
+			return true, &corev1.PodList{Items: []corev1.Pod{pod}}, nil
+		})
+	fakeCore.AddReactor("list", "events",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			return true, &corev1.EventList{Items: events}, nil
+		})
+	// the first watch delivers the watched events and is closed, like on a
+	// server side timeout; watching again ends the command with an error
+	// telling where the watch has been resumed
+	watcher := watch.NewFakeWithChanSize(len(watched), false)
+	for i := range watched {
+		watched[i].ResourceVersion = strconv.Itoa(i + 1)
+		watcher.Add(&watched[i])
+	}
+	watcher.Stop()
+	watches := 0
+	fakeCore.AddWatchReactor("events",
+		func(a client_testing.Action) (bool, watch.Interface, error) {
+			watches++
+			if watches > 1 {
+				return true, nil, fmt.Errorf("%s %s", errRewatched, a.(client_testing.WatchAction).GetWatchRestrictions().ResourceVersion)
+			}
+			return true, watcher, nil
+		})
+
+	cmd.SetArgs(args)
+	err = cmd.Execute()
+	output = buf.String()
+	return
+}
+
+const errRewatched = "watched again from"
+
+func newEvent(kind, name, reason string, lastSeen time.Duration) corev1.Event {
+	return corev1.Event{
+		InvolvedObject: corev1.ObjectReference{Kind: kind, Name: name},
+		Type:           corev1.EventTypeNormal,
+		Reason:         reason,
+		Message:        reason + " " + name,
+		LastTimestamp:  metav1.NewTime(time.Now().Add(-lastSeen)),
+	}
+}
+
+func TestServiceEvents(t *testing.T) {
+	events := []corev1.Event{
+		newEvent("Pod", "foo-00001-deployment-5d8f-x7k2p", "Pulling", 2*time.Minute),
+		newEvent("Service", "foo", "Created", 10*time.Minute),
+		newEvent("Pod", "bar-00001-deployment-5d8f-x7k2p", "Pulling", 3*time.Minute),
+		newEvent("ReplicaSet", "foo-00001-deployment-5d8f", "SuccessfulCreate", 4*time.Minute),
+		newEvent("Deployment", "foo-00001-deployment", "ScalingReplicaSet", 5*time.Minute),
+		newEvent("Deployment", "foo-00001-other", "ScalingReplicaSet", 5*time.Minute),
+		newEvent("Revision", "foo-00001", "RevisionReady", time.Minute),
+		newEvent("Service", "bar", "Created", 8*time.Minute),
+	}
+	output, _, err := fakeServiceEvents([]string{"service", "events", "foo"}, events, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	expected := []string{
+		"service/foo",
+		"deployment/foo-00001-deployment",
+		"replicaset/foo-00001-deployment-5d8f",
+		"pod/foo-00001-deployment-5d8f-x7k2p",
+		"revision/foo-00001",
+	}
+	if len(lines) != len(expected)+1 || !strings.Contains(lines[0], "LAST SEEN") {
+		t.Fatalf("unexpected output:\n%s", output)
+	}
+	for i, object := range expected {
+		if !strings.Contains(lines[i+1], object) {
+			t.Errorf("expected %s in line %d, got: %s", object, i+1, lines[i+1])
+		}
+	}
+	if strings.Contains(output, "bar") {
+		t.Errorf("events of other services listed:\n%s", output)
+	}
+}
+
+func TestServiceEventsNone(t *testing.T) {
+	output, _, err := fakeServiceEvents([]string{"service", "events", "foo"}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(output, "No events found for service 'foo'.") {
+		t.Fatalf("unexpected output:\n%s", output)
+	}
+}
+
+func TestServiceEventsWatch(t *testing.T) {
+	events := []corev1.Event{newEvent("Service", "foo", "Created", time.Minute)}
+	watched := []corev1.Event{
+		newEvent("Service", "bar", "Created", 0),
+		newEvent("Route", "foo", "Updated", 0),
+	}
+	output, _, err := fakeServiceEvents([]string{"service", "events", "foo", "--watch"}, events, watched)
+	if err == nil || err.Error() != errRewatched+" 2" {
+		t.Fatalf("expected watch to be resumed after the last event, got %v", err)
+	}
+	if !strings.Contains(output, "route/foo") || strings.Contains(output, "bar") {
+		t.Fatalf("unexpected output:\n%s", output)
+	}
+}
+
+func TestServiceEventsWatchNewRevision(t *testing.T) {
+	watched := []corev1.Event{
+		newEvent("Pod", "bar-00002-deployment-7c9d-q8w4z", "Pulling", 0),
+		newEvent("Revision", "bar-00002", "RevisionReady", 0),
+		newEvent("Deployment", "foo-00002-deployment", "ScalingReplicaSet", 0),
+		newEvent("Pod", "foo-00002-deployment-7c9d-q8w4z", "Pulling", 0),
+	}
+	_, collected, err := fakeServiceEvents([]string{"service", "events", "foo", "--watch"}, nil, watched)
+	if err == nil || !strings.HasPrefix(err.Error(), errRewatched) {
+		t.Fatalf("expected watch to be resumed, got %v", err)
+	}
+	// once initially and once each for the deployment and the pod of the
+	// service's new revision
+	if collected != 3 {
+		t.Errorf("expected the service's resources to be collected 3 times, got %d", collected)
+	}
+}
@@ -38,16 +38,17 @@ func (f *ServiceGetFlags) AllowedFormats() []string {
 // ToPrinter attempts to find a composed set of ServiceGetFlags suitable for
 // returning a printer based on current flag values.
 func (f *ServiceGetFlags) ToPrinter() (hprinters.ResourcePrinter, error) {
+	outputFormat := *f.GenericPrintFlags.OutputFormat
 	// if there are flags specified for generic printing
-	if f.GenericPrintFlags.OutputFlagSpecified() {
+	if f.GenericPrintFlags.OutputFlagSpecified() && !f.HumanReadableFlags.IsHumanReadableFormat(outputFormat) {
 		p, err := f.GenericPrintFlags.ToPrinter()
 		if err != nil {
 			return nil, err
 		}
 		return p, nil
 	}
-	// if no flags specified, use the table printing
-	p, err := f.HumanReadableFlags.ToPrinter(ServiceGetHandlers)
+	// otherwise use the table printing
+	p, err := f.HumanReadableFlags.ToPrinter(outputFormat, ServiceGetHandlers)
 	if err != nil {
 		return nil, err
 	}
@@ -19,8 +19,11 @@ import (
 	"testing"
 
 	"github.com/knative/client/pkg/kn/commands"
+	servinglib "github.com/knative/client/pkg/serving"
+	"github.com/knative/pkg/apis"
 	duckv1beta1 "github.com/knative/pkg/apis/duck/v1beta1"
 	v1alpha1 "github.com/knative/serving/pkg/apis/serving/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	client_testing "k8s.io/client-go/testing"
@@ -76,6 +79,83 @@ func TestServiceGetDefaultOutput(t *testing.T) {
 	testContains(t, output[2], []string{"bar", "bar.default.example.com", "2"}, "value")
 }
 
+func TestServiceGetClusterLocal(t *testing.T) {
+	service := createMockServiceWithParams("foo", "foo.default.example.com", 1)
+	service.Labels = map[string]string{servinglib.VisibilityLabelKey: servinglib.VisibilityClusterLocal}
+	service.Status.DeprecatedDomainInternal = "foo.default.svc.cluster.local"
+	serviceList := &v1alpha1.ServiceList{Items: []v1alpha1.Service{*service}}
+	_, output, err := fakeServiceGet([]string{"service", "get"}, serviceList)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testContains(t, output[1], []string{"foo", "foo.default.svc.cluster.local"}, "value")
+	if strings.Contains(output[1], "foo.default.example.com") {
+		t.Errorf("external domain shown for cluster-local service: %s", output[1])
+	}
+}
+
+func TestServiceGetWideOutput(t *testing.T) {
+	service := createMockServiceWithParams("foo", "foo.default.example.com", 1)
+	service.Spec.DeprecatedRunLatest = nil
+	service.Spec.Template = &v1alpha1.RevisionTemplateSpec{}
+	service.Spec.Template.Spec.DeprecatedContainer = &corev1.Container{Image: "gcr.io/foo/bar:v1"}
+	servinglib.UpdateConcurrencyConfiguration(service.Spec.Template, 0, 0, 5, 10)
+	service.Status.URL = &apis.URL{Scheme: "http", Host: "foo.default.example.com"}
+	service.Status.LatestCreatedRevisionName = "foo-abcd"
+	service.Status.LatestReadyRevisionName = "foo-wxyz"
+	serviceList := &v1alpha1.ServiceList{Items: []v1alpha1.Service{*service}}
+
+	_, output, err := fakeServiceGet([]string{"service", "get"}, serviceList)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(output[0], "LATESTCREATED") || strings.Contains(output[1], "foo-abcd") {
+		t.Errorf("wide columns shown without -o wide:\n%s\n%s", output[0], output[1])
+	}
+
+	_, output, err = fakeServiceGet([]string{"service", "get", "-o", "wide"}, serviceList)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testContains(t, output[0], []string{"NAME", "DOMAIN", "URL", "LATESTCREATED", "LATESTREADY", "GENERATION", "IMAGE", "CONCURRENCY"}, "column header")
+	testContains(t, output[1], []string{"foo", "http://foo.default.example.com", "foo-abcd", "foo-wxyz", "gcr.io/foo/bar:v1", "limit 10, target 5"}, "value")
+}
+
+func TestServiceGetTableOptions(t *testing.T) {
+	service1 := createMockServiceWithParams("foo", "foo.default.example.com", 2)
+	service1.Labels = map[string]string{"app.example.com/tier": "backend", "team": "a"}
+	service2 := createMockServiceWithParams("bar", "bar.default.example.com", 1)
+	service2.Labels = map[string]string{"team": "b"}
+	service3 := createMockServiceWithParams("baz", "baz.default.example.com", 3)
+	serviceList := &v1alpha1.ServiceList{Items: []v1alpha1.Service{*service1, *service2, *service3}}
+
+	_, output, err := fakeServiceGet([]string{"service", "get", "--no-headers", "--sort-by", "{.metadata.name}"}, serviceList)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testContains(t, output[0], []string{"bar"}, "value")
+	testContains(t, output[1], []string{"baz"}, "value")
+	testContains(t, output[2], []string{"foo"}, "value")
+
+	_, output, err = fakeServiceGet([]string{"service", "get", "--sort-by", "status.observedGeneration",
+		"-L", "app.example.com/tier,team", "--show-labels"}, serviceList)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testContains(t, output[0], []string{"NAME", "REASON", "TIER", "TEAM", "LABELS"}, "column header")
+	testContains(t, output[1], []string{"bar", "b", "team=b"}, "value")
+	testContains(t, output[2], []string{"foo", "backend", "app.example.com/tier=backend,team=a"}, "value")
+	testContains(t, output[3], []string{"baz", "<none>"}, "value")
+}
+
+func TestServiceGetInvalidSortBy(t *testing.T) {
+	serviceList := &v1alpha1.ServiceList{Items: []v1alpha1.Service{*createMockServiceWithParams("foo", "", 1)}}
+	_, _, err := fakeServiceGet([]string{"service", "get", "--sort-by", "{.metadata.name"}, serviceList)
+	if err == nil || !strings.Contains(err.Error(), "invalid sort field") {
+		t.Fatalf("expected error for invalid sort field, got %v", err)
+	}
+}
+
 func testContains(t *testing.T, output string, sub []string, element string) {
 	for _, each := range sub {
 		if !strings.Contains(output, each) {
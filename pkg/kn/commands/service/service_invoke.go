@@ -0,0 +1,189 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptrace"
+	"strings"
+	"time"
+
+	"github.com/knative/client/pkg/kn/commands"
+	servinglib "github.com/knative/client/pkg/serving"
+	"github.com/spf13/cobra"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type invokeFlags struct {
+	Ingress string
+	Method  string
+	Path    string
+	Headers []string
+	Data    string
+	Timing  bool
+}
+
+// NewServiceInvokeCommand represents 'kn service invoke' command
+func NewServiceInvokeCommand(p *commands.KnParams) *cobra.Command {
+	var flags invokeFlags
+
+	serviceInvokeCommand := &cobra.Command{
+		Use:   "invoke NAME",
+		Short: "Send an HTTP request to a service.",
+		Example: `
+  # Send a GET request to service 'svc1'
+  kn service invoke svc1
+
+  # POST the content of 'payload.json' to path '/api' of service 'svc1'
+  kn service invoke svc1 --path /api -H "Content-Type: application/json" -d @payload.json
+
+  # Send the request through a given ingress address and print timing information
+  kn service invoke svc1 --ingress 192.168.99.100:31380 --timing`,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("requires the service name.")
+			}
+			namespace, err := commands.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.ServingFactory()
+			if err != nil {
+				return err
+			}
+			service, err := client.Services(namespace).Get(args[0], v1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			coreClient, err := p.CoreFactory()
+			if err != nil {
+				return err
+			}
+			url, host, err := servinglib.GetServiceRequestTarget(coreClient, service, flags.Ingress)
+			if err != nil {
+				return err
+			}
+
+			req, err := flags.newRequest(url, host)
+			if err != nil {
+				return err
+			}
+			return invoke(cmd.OutOrStdout(), req, flags.Timing)
+		},
+	}
+	commands.AddNamespaceFlags(serviceInvokeCommand.Flags(), false)
+	flags.AddFlags(serviceInvokeCommand)
+	return serviceInvokeCommand
+}
+
+func (f *invokeFlags) AddFlags(command *cobra.Command) {
+	command.Flags().StringVar(&f.Ingress, "ingress", "", "Address (HOST[:PORT]) or URL of the ingress to send the request to. Looked up from the cluster if not given.")
+	command.Flags().StringVarP(&f.Method, "method", "X", "", "HTTP method to use. Defaults to POST when --data is given, GET otherwise.")
+	command.Flags().StringVar(&f.Path, "path", "/", "Path of the request.")
+	command.Flags().StringArrayVarP(&f.Headers, "header", "H", []string{},
+		"Header to add to the request. 'Name: value'; you may provide this flag "+
+			"any number of times to set multiple headers.")
+	command.Flags().StringVarP(&f.Data, "data", "d", "", "Body of the request. Use @FILE to read the body from a file.")
+	command.Flags().BoolVar(&f.Timing, "timing", false, "Print timing information of the request.")
+}
+
+// newRequest creates the HTTP request addressed to the ingress URL, using the
+// service's host for routing
+func (f *invokeFlags) newRequest(url string, host string) (*http.Request, error) {
+	var body io.Reader
+	if f.Data != "" {
+		data, err := commands.ReadData(f.Data)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewReader(data)
+	}
+
+	method := f.Method
+	if method == "" {
+		method = http.MethodGet
+		if body != nil {
+			method = http.MethodPost
+		}
+	}
+
+	if !strings.HasPrefix(f.Path, "/") {
+		url += "/"
+	}
+	req, err := http.NewRequest(strings.ToUpper(method), url+f.Path, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Host = host
+
+	for _, header := range f.Headers {
+		pair := strings.SplitN(header, ":", 2)
+		if len(pair) <= 1 {
+			return nil, fmt.Errorf(
+				"--header argument requires a value that contains the ':' character; got %s",
+				header)
+		}
+		req.Header.Add(strings.TrimSpace(pair[0]), strings.TrimSpace(pair[1]))
+	}
+	return req, nil
+}
+
+// invoke sends the request and prints the response body, optionally
+// followed by timing information
+func invoke(out io.Writer, req *http.Request, timing bool) error {
+	var start, connected, firstByte time.Time
+	trace := &httptrace.ClientTrace{
+		ConnectDone: func(network, addr string, err error) {
+			connected = time.Now()
+		},
+		GotFirstResponseByte: func() {
+			firstByte = time.Now()
+		},
+	}
+	req = req.WithContext(httptrace.WithClientTrace(req.Context(), trace))
+
+	start = time.Now()
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, err = io.Copy(out, resp.Body)
+	if err != nil {
+		return err
+	}
+	done := time.Now()
+
+	if timing {
+		fmt.Fprintln(out)
+		if !connected.IsZero() {
+			fmt.Fprintf(out, "Connect:    %v\n", connected.Sub(start))
+		}
+		if !firstByte.IsZero() {
+			fmt.Fprintf(out, "First byte: %v\n", firstByte.Sub(start))
+		}
+		fmt.Fprintf(out, "Total:      %v\n", done.Sub(start))
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("request failed with status '%s'", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/knative/serving/pkg/apis/serving/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	client_testing "k8s.io/client-go/testing"
+)
+
+func fakeServiceInvoke(args []string, service *v1alpha1.Service, gateway *corev1.Service) (output string, err error) {
+	knParams := &commands.KnParams{}
+	cmd, fakeServing, fakeCore, buf := commands.CreateTestKnCommandWithCore(NewServiceCommand(knParams), knParams)
+	fakeServing.AddReactor("get", "services",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			return true, service, nil
+		})
+	fakeCore.AddReactor("get", "services",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			return true, gateway, nil
+		})
+	cmd.SetArgs(args)
+	err = cmd.Execute()
+	output = buf.String()
+	return
+}
+
+type recordedRequest struct {
+	method, host, path, header, body string
+}
+
+func newIngressServer(t *testing.T, status int, recorded *recordedRequest) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		*recorded = recordedRequest{r.Method, r.Host, r.URL.Path, r.Header.Get("X-Test"), string(body)}
+		w.WriteHeader(status)
+		w.Write([]byte("Hello Knative!"))
+	}))
+}
+
+func TestServiceInvokeGet(t *testing.T) {
+	var recorded recordedRequest
+	server := newIngressServer(t, http.StatusOK, &recorded)
+	defer server.Close()
+
+	service := createMockServiceWithParams("foo", "foo.default.example.com", 1)
+	output, err := fakeServiceInvoke([]string{
+		"service", "invoke", "foo", "--ingress", server.URL, "-H", "X-Test: kn"}, service, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if recorded.method != "GET" || recorded.host != "foo.default.example.com" ||
+		recorded.path != "/" || recorded.header != "kn" {
+		t.Fatalf("wrong request received: %v", recorded)
+	}
+	if output != "Hello Knative!" {
+		t.Fatalf("wrong output: %s", output)
+	}
+}
+
+func TestServiceInvokePostDataFromFile(t *testing.T) {
+	var recorded recordedRequest
+	server := newIngressServer(t, http.StatusOK, &recorded)
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "kn-invoke")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "payload.json")
+	err = ioutil.WriteFile(file, []byte(`{"msg":"hello"}`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	service := createMockServiceWithParams("foo", "foo.default.example.com", 1)
+	output, err := fakeServiceInvoke([]string{
+		"service", "invoke", "foo", "--ingress", strings.TrimPrefix(server.URL, "http://"),
+		"--path", "/api", "-d", "@" + file, "--timing"}, service, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if recorded.method != "POST" || recorded.path != "/api" || recorded.body != `{"msg":"hello"}` {
+		t.Fatalf("wrong request received: %v", recorded)
+	}
+	if !strings.Contains(output, "Hello Knative!") || !strings.Contains(output, "Total:") {
+		t.Fatalf("wrong output: %s", output)
+	}
+}
+
+func TestServiceInvokeErrorStatus(t *testing.T) {
+	var recorded recordedRequest
+	server := newIngressServer(t, http.StatusNotFound, &recorded)
+	defer server.Close()
+
+	service := createMockServiceWithParams("foo", "foo.default.example.com", 1)
+	_, err := fakeServiceInvoke([]string{
+		"service", "invoke", "foo", "--ingress", server.URL, "-X", "delete"}, service, nil)
+	if err == nil || !strings.Contains(err.Error(), "404") {
+		t.Fatalf("expected error for status 404, got %v", err)
+	}
+	if recorded.method != "DELETE" {
+		t.Fatalf("wrong method: %s", recorded.method)
+	}
+}
+
+func TestServiceInvokeNoIngress(t *testing.T) {
+	service := createMockServiceWithParams("foo", "foo.default.example.com", 1)
+	_, err := fakeServiceInvoke([]string{"service", "invoke", "foo"}, service, &corev1.Service{})
+	if err == nil || !strings.Contains(err.Error(), "--ingress") {
+		t.Fatalf("expected error pointing to --ingress, got %v", err)
+	}
+}
@@ -0,0 +1,288 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/knative/client/pkg/kn/commands"
+	hprinters "github.com/knative/client/pkg/printers"
+	duckv1beta1 "github.com/knative/pkg/apis/duck/v1beta1"
+	autoscalingv1alpha1 "github.com/knative/serving/pkg/apis/autoscaling/v1alpha1"
+	"github.com/knative/serving/pkg/apis/serving"
+	servingv1alpha1 "github.com/knative/serving/pkg/apis/serving/v1alpha1"
+	serving_v1alpha1_client "github.com/knative/serving/pkg/client/clientset/versioned/typed/serving/v1alpha1"
+	"github.com/spf13/cobra"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+// Resources owned by a revision, which are accessed with the dynamic client
+var (
+	deploymentResource    = appsv1.SchemeGroupVersion.WithResource("deployments")
+	podAutoscalerResource = autoscalingv1alpha1.SchemeGroupVersion.WithResource("podautoscalers")
+)
+
+// treeNode is a resource in the ownership tree of a service
+type treeNode struct {
+	Kind     string      `json:"kind"`
+	Name     string      `json:"name"`
+	Ready    string      `json:"ready"`
+	Reason   string      `json:"reason,omitempty"`
+	Children []*treeNode `json:"children,omitempty"`
+}
+
+// serviceTreeBuilder walks the resources of a service with the clients
+// for the respective API groups
+type serviceTreeBuilder struct {
+	client        serving_v1alpha1_client.ServingV1alpha1Interface
+	coreClient    corev1client.CoreV1Interface
+	dynamicClient dynamic.Interface
+	namespace     string
+}
+
+// NewServiceTreeCommand represents 'kn service tree' command
+func NewServiceTreeCommand(p *commands.KnParams) *cobra.Command {
+	var output string
+
+	serviceTreeCommand := &cobra.Command{
+		Use:   "tree NAME",
+		Short: "Show the resources a service consists of.",
+		Example: `
+  # Show the configuration, route, revisions, deployments, pod autoscalers and pods of service 'svc1'
+  kn service tree svc1
+
+  # Print the tree of service 'svc1' as JSON
+  kn service tree svc1 -o json`,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("requires the service name.")
+			}
+			if output != "" && output != "json" {
+				return fmt.Errorf("invalid output format '%s', only 'json' is supported.", output)
+			}
+			namespace, err := commands.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			builder := &serviceTreeBuilder{namespace: namespace}
+			builder.client, err = p.ServingFactory()
+			if err != nil {
+				return err
+			}
+			builder.coreClient, err = p.CoreFactory()
+			if err != nil {
+				return err
+			}
+			builder.dynamicClient, err = p.DynamicFactory()
+			if err != nil {
+				return err
+			}
+
+			tree, err := builder.build(args[0])
+			if err != nil {
+				return err
+			}
+			if output == "json" {
+				encoder := json.NewEncoder(cmd.OutOrStdout())
+				encoder.SetIndent("", "  ")
+				return encoder.Encode(tree)
+			}
+			out := hprinters.GetNewTabWriter(cmd.OutOrStdout())
+			defer out.Flush()
+			fmt.Fprintln(out, "NAME\tREADY\tREASON")
+			printTree(out, tree, 0)
+			return nil
+		},
+	}
+	commands.AddNamespaceFlags(serviceTreeCommand.Flags(), false)
+	serviceTreeCommand.Flags().StringVarP(&output, "output", "o", "", "Output format. Only 'json' is supported, an indented tree is printed by default.")
+	return serviceTreeCommand
+}
+
+// build walks from the service to its configurations and routes, which
+// are found by the service label, and on to the revisions of the
+// configurations
+func (b *serviceTreeBuilder) build(name string) (*treeNode, error) {
+	service, err := b.client.Services(b.namespace).Get(name, v1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	root := newKnativeNode("Service", service.Name, service.Status.Conditions)
+
+	serviceSelector := v1.ListOptions{LabelSelector: serving.ServiceLabelKey + "=" + name}
+	configurations, err := b.client.Configurations(b.namespace).List(serviceSelector)
+	if err != nil {
+		return nil, err
+	}
+	for _, configuration := range configurations.Items {
+		node := newKnativeNode("Configuration", configuration.Name, configuration.Status.Conditions)
+		revisions, err := b.client.Revisions(b.namespace).List(v1.ListOptions{
+			LabelSelector: serving.ConfigurationLabelKey + "=" + configuration.Name,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for i := range revisions.Items {
+			revisionNode, err := b.buildRevision(&revisions.Items[i])
+			if err != nil {
+				return nil, err
+			}
+			node.Children = append(node.Children, revisionNode)
+		}
+		root.Children = append(root.Children, node)
+	}
+
+	routes, err := b.client.Routes(b.namespace).List(serviceSelector)
+	if err != nil {
+		return nil, err
+	}
+	for _, route := range routes.Items {
+		root.Children = append(root.Children, newKnativeNode("Route", route.Name, route.Status.Conditions))
+	}
+	return root, nil
+}
+
+// buildRevision adds the deployments and pod autoscalers owned by the
+// revision as well as the revision's pods
+func (b *serviceTreeBuilder) buildRevision(revision *servingv1alpha1.Revision) (*treeNode, error) {
+	node := newKnativeNode("Revision", revision.Name, revision.Status.Conditions)
+	revisionSelector := v1.ListOptions{LabelSelector: serving.RevisionLabelKey + "=" + revision.Name}
+
+	deployments, err := listOwnedByRevision(b.dynamicClient, deploymentResource, b.namespace, revision)
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range deployments {
+		deployment := &appsv1.Deployment{}
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, deployment)
+		if err != nil {
+			return nil, err
+		}
+		node.Children = append(node.Children, newDeploymentNode(deployment))
+	}
+
+	podAutoscalers, err := listOwnedByRevision(b.dynamicClient, podAutoscalerResource, b.namespace, revision)
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range podAutoscalers {
+		podAutoscaler := &autoscalingv1alpha1.PodAutoscaler{}
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, podAutoscaler)
+		if err != nil {
+			return nil, err
+		}
+		node.Children = append(node.Children, newKnativeNode("PodAutoscaler", podAutoscaler.Name, podAutoscaler.Status.Conditions))
+	}
+
+	pods, err := b.coreClient.Pods(b.namespace).List(revisionSelector)
+	if err != nil {
+		return nil, err
+	}
+	for i := range pods.Items {
+		node.Children = append(node.Children, newPodNode(&pods.Items[i]))
+	}
+	return node, nil
+}
+
+// listOwnedByRevision lists the resources which carry the label of the
+// given revision and are owned by it
+func listOwnedByRevision(client dynamic.Interface, resource schema.GroupVersionResource, namespace string, revision *servingv1alpha1.Revision) ([]unstructured.Unstructured, error) {
+	revisionSelector := v1.ListOptions{LabelSelector: serving.RevisionLabelKey + "=" + revision.Name}
+	list, err := client.Resource(resource).Namespace(namespace).List(revisionSelector)
+	if err != nil {
+		return nil, err
+	}
+	var owned []unstructured.Unstructured
+	for _, obj := range list.Items {
+		if isOwnedBy(obj.GetOwnerReferences(), revision) {
+			owned = append(owned, obj)
+		}
+	}
+	return owned, nil
+}
+
+func isOwnedBy(owners []v1.OwnerReference, revision *servingv1alpha1.Revision) bool {
+	for _, owner := range owners {
+		if owner.Kind == "Revision" && owner.Name == revision.Name {
+			return true
+		}
+	}
+	return false
+}
+
+func newKnativeNode(kind, name string, conditions duckv1beta1.Conditions) *treeNode {
+	return &treeNode{
+		Kind:   kind,
+		Name:   name,
+		Ready:  commands.ReadyCondition(conditions),
+		Reason: commands.NonReadyConditionReason(conditions),
+	}
+}
+
+// newDeploymentNode reports a deployment as ready when it's available
+func newDeploymentNode(deployment *appsv1.Deployment) *treeNode {
+	node := &treeNode{Kind: "Deployment", Name: deployment.Name, Ready: "<unknown>"}
+	for _, condition := range deployment.Status.Conditions {
+		if condition.Type == appsv1.DeploymentAvailable {
+			node.Ready = string(condition.Status)
+			if condition.Status != corev1.ConditionTrue {
+				node.Reason = condition.Reason
+			}
+		}
+	}
+	return node
+}
+
+// newPodNode reports the reason why a container is waiting, like
+// ImagePullBackOff, for pods which aren't ready
+func newPodNode(pod *corev1.Pod) *treeNode {
+	node := &treeNode{Kind: "Pod", Name: pod.Name, Ready: "<unknown>"}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			node.Ready = string(condition.Status)
+			node.Reason = condition.Reason
+		}
+	}
+	if node.Ready == string(corev1.ConditionTrue) {
+		node.Reason = ""
+		return node
+	}
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.State.Waiting != nil && status.State.Waiting.Reason != "" {
+			node.Reason = status.State.Waiting.Reason
+			break
+		}
+	}
+	return node
+}
+
+func printTree(out io.Writer, node *treeNode, depth int) {
+	fmt.Fprintf(out, "%s%s/%s\t%s\t%s\n", strings.Repeat("  ", depth), strings.ToLower(node.Kind), node.Name, node.Ready, node.Reason)
+	for _, child := range node.Children {
+		printTree(out, child, depth+1)
+	}
+}
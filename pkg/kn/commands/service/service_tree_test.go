@@ -0,0 +1,180 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/knative/pkg/apis"
+	duckv1beta1 "github.com/knative/pkg/apis/duck/v1beta1"
+	"github.com/knative/serving/pkg/apis/serving"
+	"github.com/knative/serving/pkg/apis/serving/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	client_testing "k8s.io/client-go/testing"
+)
+
+func readyConditions(status corev1.ConditionStatus, reason string) duckv1beta1.Status {
+	return duckv1beta1.Status{Conditions: duckv1beta1.Conditions{
+		{Type: apis.ConditionReady, Status: status, Reason: reason},
+	}}
+}
+
+func ownedByRevision(kind, name string) unstructured.Unstructured {
+	obj := unstructured.Unstructured{}
+	obj.SetKind(kind)
+	obj.SetName(name)
+	obj.SetLabels(map[string]string{serving.RevisionLabelKey: "foo-00001"})
+	obj.SetOwnerReferences([]metav1.OwnerReference{{Kind: "Revision", Name: "foo-00001"}})
+	return obj
+}
+
+func fakeServiceTree(args []string) (output string, err error) {
+	knParams := &commands.KnParams{}
+	cmd, fakeServing, fakeCore, buf := commands.CreateTestKnCommandWithCore(NewServiceCommand(knParams), knParams)
+	fakeDynamic := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+	knParams.DynamicFactory = func() (dynamic.Interface, error) { return fakeDynamic, nil }
+
+	fakeServing.AddReactor("get", "services",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			service := createMockServiceWithParams("foo", "foo.default.example.com", 1)
+			service.Status.Status = readyConditions(corev1.ConditionFalse, "RevisionMissing")
+			return true, service, nil
+		})
+	fakeServing.AddReactor("list", "configurations",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			configuration := v1alpha1.Configuration{ObjectMeta: metav1.ObjectMeta{
+				Name:   "foo",
+				Labels: map[string]string{serving.ServiceLabelKey: "foo"},
+			}}
+			configuration.Status.Status = readyConditions(corev1.ConditionTrue, "")
+			return true, &v1alpha1.ConfigurationList{Items: []v1alpha1.Configuration{configuration}}, nil
+		})
+	fakeServing.AddReactor("list", "routes",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			route := v1alpha1.Route{ObjectMeta: metav1.ObjectMeta{
+				Name:   "foo",
+				Labels: map[string]string{serving.ServiceLabelKey: "foo"},
+			}}
+			return true, &v1alpha1.RouteList{Items: []v1alpha1.Route{route}}, nil
+		})
+	fakeServing.AddReactor("list", "revisions",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			revision := v1alpha1.Revision{ObjectMeta: metav1.ObjectMeta{
+				Name:   "foo-00001",
+				Labels: map[string]string{serving.ConfigurationLabelKey: "foo"},
+			}}
+			revision.Status.Status = readyConditions(corev1.ConditionUnknown, "Deploying")
+			return true, &v1alpha1.RevisionList{Items: []v1alpha1.Revision{revision}}, nil
+		})
+	fakeDynamic.PrependReactor("list", "deployments",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			deployment := ownedByRevision("Deployment", "foo-00001-deployment")
+			unstructured.SetNestedSlice(deployment.Object, []interface{}{
+				map[string]interface{}{"type": "Available", "status": "False", "reason": "MinimumReplicasUnavailable"},
+			}, "status", "conditions")
+			other := ownedByRevision("Deployment", "other")
+			other.SetOwnerReferences(nil)
+			return true, &unstructured.UnstructuredList{Items: []unstructured.Unstructured{deployment, other}}, nil
+		})
+	fakeDynamic.PrependReactor("list", "podautoscalers",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			podAutoscaler := ownedByRevision("PodAutoscaler", "foo-00001")
+			return true, &unstructured.UnstructuredList{Items: []unstructured.Unstructured{podAutoscaler}}, nil
+		})
+	fakeCore.AddReactor("list", "pods",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			pod := corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+				Name:   "foo-00001-deployment-5d8f-x7k2p",
+				Labels: map[string]string{serving.RevisionLabelKey: "foo-00001"},
+			}}
+			pod.Status.Conditions = []corev1.PodCondition{
+				{Type: corev1.PodReady, Status: corev1.ConditionFalse, Reason: "ContainersNotReady"},
+			}
+			pod.Status.ContainerStatuses = []corev1.ContainerStatus{{
+				State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff"}},
+			}}
+			return true, &corev1.PodList{Items: []corev1.Pod{pod}}, nil
+		})
+
+	cmd.SetArgs(args)
+	err = cmd.Execute()
+	output = buf.String()
+	return
+}
+
+func TestServiceTree(t *testing.T) {
+	output, err := fakeServiceTree([]string{"service", "tree", "foo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	expected := [][]string{
+		{"NAME", "READY", "REASON"},
+		{"service/foo", "False", "RevisionMissing"},
+		{"  configuration/foo", "True"},
+		{"    revision/foo-00001", "Unknown", "Deploying"},
+		{"      deployment/foo-00001-deployment", "False", "MinimumReplicasUnavailable"},
+		{"      podautoscaler/foo-00001", "<unknown>"},
+		{"      pod/foo-00001-deployment-5d8f-x7k2p", "False", "ImagePullBackOff"},
+		{"  route/foo", "<unknown>"},
+	}
+	if len(lines) != len(expected) {
+		t.Fatalf("unexpected output:\n%s", output)
+	}
+	for i, parts := range expected {
+		if !strings.HasPrefix(lines[i], parts[0]) {
+			t.Errorf("expected line %d to start with '%s', got: %s", i, parts[0], lines[i])
+		}
+		for _, part := range parts[1:] {
+			if !strings.Contains(lines[i], part) {
+				t.Errorf("expected '%s' in line %d, got: %s", part, i, lines[i])
+			}
+		}
+	}
+}
+
+func TestServiceTreeJSON(t *testing.T) {
+	output, err := fakeServiceTree([]string{"service", "tree", "foo", "-o", "json"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tree := &treeNode{}
+	err = json.Unmarshal([]byte(output), tree)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tree.Kind != "Service" || len(tree.Children) != 2 {
+		t.Fatalf("unexpected tree: %s", output)
+	}
+	revision := tree.Children[0].Children[0]
+	if revision.Name != "foo-00001" || len(revision.Children) != 3 {
+		t.Fatalf("unexpected revision node: %v", revision)
+	}
+}
+
+func TestServiceTreeInvalidOutput(t *testing.T) {
+	_, err := fakeServiceTree([]string{"service", "tree", "foo", "-o", "yaml"})
+	if err == nil || !strings.Contains(err.Error(), "only 'json' is supported") {
+		t.Fatalf("expected error for output format, got %v", err)
+	}
+}
@@ -17,7 +17,10 @@ package service
 import (
 	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/knative/client/pkg/kn/commands"
@@ -298,6 +301,120 @@ func TestServiceUpdateRequestsLimitsCPU_and_Memory(t *testing.T) {
 	}
 }
 
+func TestServiceUpdateClusterLocal(t *testing.T) {
+	original := newEmptyService()
+
+	_, updated, _, err := fakeServiceUpdate(original, []string{
+		"service", "update", "foo", "--cluster-local"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Labels[servinglib.VisibilityLabelKey] != servinglib.VisibilityClusterLocal {
+		t.Fatalf("visibility label not set: %v", updated.Labels)
+	}
+
+	_, updated, _, err = fakeServiceUpdate(updated, []string{
+		"service", "update", "foo", "--no-cluster-local"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, present := updated.Labels[servinglib.VisibilityLabelKey]; present {
+		t.Fatalf("visibility label not removed: %v", updated.Labels)
+	}
+
+	_, _, _, err = fakeServiceUpdate(original, []string{
+		"service", "update", "foo", "--cluster-local", "--no-cluster-local"})
+	if err == nil {
+		t.Fatal("expected error for conflicting flags")
+	}
+}
+
+func TestServiceUpdateProbes(t *testing.T) {
+	original := newEmptyService()
+
+	_, updated, _, err := fakeServiceUpdate(original, []string{
+		"service", "update", "foo", "--readiness-probe", "tcp", "--liveness-probe", "http:/healthz"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, updated, _, err = fakeServiceUpdate(updated, []string{
+		"service", "update", "foo", "--readiness-probe-options", "failure-threshold=10", "--no-liveness-probe"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	template, err := servinglib.GetRevisionTemplate(updated)
+	if err != nil {
+		t.Fatal(err)
+	}
+	container := template.Spec.DeprecatedContainer
+	expected := &corev1.Probe{
+		Handler:          corev1.Handler{TCPSocket: &corev1.TCPSocketAction{}},
+		FailureThreshold: 10,
+	}
+	if !reflect.DeepEqual(container.ReadinessProbe, expected) {
+		t.Fatalf("wrong readiness probe %v", container.ReadinessProbe)
+	}
+	if container.LivenessProbe != nil {
+		t.Fatalf("liveness probe not removed: %v", container.LivenessProbe)
+	}
+
+	_, _, _, err = fakeServiceUpdate(newEmptyService(), []string{
+		"service", "update", "foo", "--liveness-probe-options", "period=5"})
+	if err == nil || !strings.Contains(err.Error(), "requires --liveness-probe") {
+		t.Fatalf("expected error for options without probe, got %v", err)
+	}
+	_, _, _, err = fakeServiceUpdate(newEmptyService(), []string{
+		"service", "update", "foo", "--readiness-probe", "tcp", "--no-readiness-probe"})
+	if err == nil {
+		t.Fatal("expected error for conflicting flags")
+	}
+}
+
+func TestServiceUpdateLockToDigest(t *testing.T) {
+	registry := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v2/foo/bar/manifests/baz" {
+			w.Header().Set("Content-Type", "application/vnd.docker.distribution.manifest.v2+json")
+			w.Write([]byte("{}"))
+		}
+	}))
+	defer registry.Close()
+	image := strings.TrimPrefix(registry.URL, "http://") + "/foo/bar:baz"
+
+	original := newEmptyService()
+	template, err := servinglib.GetRevisionTemplate(original)
+	if err != nil {
+		t.Fatal(err)
+	}
+	servinglib.UpdateImage(template, image)
+
+	_, updated, _, err := fakeServiceUpdate(original, []string{
+		"service", "update", "foo", "--lock-to-digest"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	template, err = servinglib.GetRevisionTemplate(updated)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(template.Spec.DeprecatedContainer.Image, "/foo/bar@sha256:") ||
+		template.Annotations[servinglib.UserImageAnnotationKey] != image {
+		t.Fatalf("image not locked to digest: %s %v", template.Spec.DeprecatedContainer.Image, template.Annotations)
+	}
+
+	_, updated, _, err = fakeServiceUpdate(updated, []string{
+		"service", "update", "foo", "--image", "gcr.io/foo/bar:v2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	template, err = servinglib.GetRevisionTemplate(updated)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, present := template.Annotations[servinglib.UserImageAnnotationKey]; present {
+		t.Fatalf("stale image annotation kept: %v", template.Annotations)
+	}
+}
+
 func newEmptyService() *v1alpha1.Service {
 	return &v1alpha1.Service{
 		TypeMeta: metav1.TypeMeta{
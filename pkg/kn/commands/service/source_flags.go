@@ -0,0 +1,83 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	buildlib "github.com/knative/client/pkg/build"
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/client-go/dynamic"
+)
+
+// SourceFlags are the flags for building the image of a service from source
+type SourceFlags struct {
+	Source         string
+	SourceRevision string
+	BuildTemplate  string
+	BuildArgs      []string
+	BuildTimeout   time.Duration
+}
+
+func (p *SourceFlags) AddFlags(command *cobra.Command) {
+	command.Flags().StringVar(&p.Source, "source", "", "URL of a git repository to build the image from. The image is pushed to the location given by --image.")
+	command.Flags().StringVar(&p.SourceRevision, "source-revision", "master", "Git revision (branch, tag or commit) to build.")
+	command.Flags().StringVar(&p.BuildTemplate, "build-template", "", "Name of the build template to build the image with.")
+	command.Flags().StringArrayVar(&p.BuildArgs, "build-arg", []string{},
+		"Argument for the build template. NAME=value; you may provide this flag "+
+			"any number of times to set multiple arguments. The argument IMAGE defaults to --image.")
+	command.Flags().DurationVar(&p.BuildTimeout, "build-timeout", 10*time.Minute, "Maximum time to wait for the build to finish.")
+}
+
+// Build the image from source and wait for the build to finish.
+// The build's progress is printed to out.
+func (p *SourceFlags) Build(client dynamic.Interface, namespace, serviceName, image string, out io.Writer) error {
+	if p.BuildTemplate == "" {
+		return errors.New("requires --build-template when building from --source.")
+	}
+	args := map[string]string{"IMAGE": image}
+	for _, pairStr := range p.BuildArgs {
+		name, value, err := commands.SplitKeyValue("build-arg", pairStr)
+		if err != nil {
+			return err
+		}
+		args[name] = value
+	}
+
+	name := fmt.Sprintf("%s-build-%s", serviceName, rand.String(5))
+	build := buildlib.NewGitBuild(namespace, name, p.Source, p.SourceRevision, p.BuildTemplate, args)
+	obj, err := buildlib.ToUnstructured(build)
+	if err != nil {
+		return err
+	}
+	_, err = client.Resource(buildlib.BuildResource).Namespace(namespace).Create(obj, metav1.CreateOptions{})
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Build '%s' started for '%s'.\n", name, p.Source)
+
+	err = buildlib.WaitForBuild(client, namespace, name, p.BuildTimeout, out)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Build '%s' succeeded, image '%s' is ready.\n", name, image)
+	return nil
+}
@@ -0,0 +1,56 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"github.com/knative/client/pkg/eventing"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// SinkFlags holds the destination events are sent to
+type SinkFlags struct {
+	Sink string
+}
+
+// Add binds the --sink flag to the given command
+func (f *SinkFlags) Add(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&f.Sink, "sink", "s", "",
+		"Addressable sink for events, either 'svc:NAME' for a Knative service, "+
+			"'broker:NAME' for a broker or an http(s) URI.")
+}
+
+// AddReference binds the --sink flag to the given command, for resources
+// which can only refer to their sink by an object reference
+func (f *SinkFlags) AddReference(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&f.Sink, "sink", "s", "",
+		"Addressable sink for events, either 'svc:NAME' for a Knative service "+
+			"or 'broker:NAME' for a broker. URIs aren't supported by this source.")
+}
+
+// Parse the sink, which must be set
+func (f *SinkFlags) Parse() (*eventing.SubscriberSpec, error) {
+	return eventing.ParseSink(f.Sink)
+}
+
+// Get the object reference of the sink, which must be set and must not be
+// a URI. Use with AddReference.
+func (f *SinkFlags) Reference() (*corev1.ObjectReference, error) {
+	sink, err := f.Parse()
+	if err != nil {
+		return nil, err
+	}
+	return eventing.SinkReference(sink)
+}
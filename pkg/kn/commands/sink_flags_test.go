@@ -0,0 +1,67 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func TestSinkFlags(t *testing.T) {
+	flags := &SinkFlags{}
+	cmd := &cobra.Command{Use: "test"}
+	flags.Add(cmd)
+	err := cmd.ParseFlags([]string{"--sink", "svc:foo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sink, err := flags.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sink.Ref == nil || sink.Ref.Kind != "Service" || sink.Ref.Name != "foo" {
+		t.Errorf("unexpected sink %v", sink)
+	}
+
+	ref, err := flags.Reference()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ref.Name != "foo" {
+		t.Errorf("unexpected reference %v", ref)
+	}
+
+	flags.Sink = "http://example.com"
+	_, err = flags.Reference()
+	if err == nil {
+		t.Error("expected error for referencing a sink URI")
+	}
+
+	flags.Sink = ""
+	_, err = flags.Parse()
+	if err == nil {
+		t.Error("expected error for missing sink")
+	}
+}
+
+func TestSinkFlagsReferenceHelp(t *testing.T) {
+	cmd := &cobra.Command{Use: "test"}
+	(&SinkFlags{}).AddReference(cmd)
+	if usage := cmd.Flags().Lookup("sink").Usage; strings.Contains(usage, "http(s) URI") {
+		t.Errorf("help of a reference sink advertises URIs: %s", usage)
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/knative/client/pkg/eventing"
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var apiServerSourceKind = &sourceKind{
+	name:      "apiserver",
+	title:     "ApiServer source",
+	kind:      "ApiServerSource",
+	resource:  eventing.ApiServerSourceResource,
+	newObject: func() runtime.Object { return &eventing.ApiServerSource{} },
+	newList:   func() runtime.Object { return &eventing.ApiServerSourceList{} },
+	describe:  describeApiServerSource,
+}
+
+func NewApiServerSourceCommand(p *commands.KnParams) *cobra.Command {
+	return newSourceKindCommand(p, apiServerSourceKind,
+		NewApiServerSourceCreateCommand(p),
+		NewApiServerSourceUpdateCommand(p))
+}
+
+func describeApiServerSource(out io.Writer, obj runtime.Object) error {
+	source := obj.(*eventing.ApiServerSource)
+	return describeSource(out, source, sinkValue(source.Spec.Sink), &source.Status, func(field func(label, value string)) {
+		field("Resources", apiServerResourcesValue(source.Spec.Resources))
+		field("Mode", source.Spec.Mode)
+		field("Service Account", source.Spec.ServiceAccountName)
+	})
+}
+
+// apiServerResourcesValue formats the watched resources like the
+// --resource flags
+func apiServerResourcesValue(resources []eventing.ApiServerResource) string {
+	values := make([]string, 0, len(resources))
+	for _, resource := range resources {
+		value := fmt.Sprintf("%s:%s", resource.Kind, resource.APIVersion)
+		if resource.Controller {
+			value += ":true"
+		}
+		values = append(values, value)
+	}
+	return strings.Join(values, ", ")
+}
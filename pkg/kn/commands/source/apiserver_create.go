@@ -0,0 +1,55 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source
+
+import (
+	"errors"
+
+	"github.com/knative/client/pkg/eventing"
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/spf13/cobra"
+)
+
+func NewApiServerSourceCreateCommand(p *commands.KnParams) *cobra.Command {
+	var editFlags ApiServerSourceEditFlags
+
+	apiServerSourceCreateCommand := &cobra.Command{
+		Use:   "create NAME --resource KIND:APIVERSION --sink SINK",
+		Short: "Create an ApiServer source.",
+		Example: `
+  # Send an event for each Kubernetes event in the default namespace to the service 'mysvc'
+  kn source apiserver create mysource --resource Event:v1 --service-account events-sa --sink svc:mysvc`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("requires the source name.")
+			}
+			if len(editFlags.Resources) == 0 {
+				return errors.New("requires at least one resource to watch.")
+			}
+			if editFlags.Sink == "" {
+				return errors.New("requires the sink to send events to.")
+			}
+			source := &eventing.ApiServerSource{}
+			_, err := editFlags.Apply(source, cmd)
+			if err != nil {
+				return err
+			}
+			return createSource(p, cmd, apiServerSourceKind, args[0], source)
+		},
+	}
+	commands.AddNamespaceFlags(apiServerSourceCreateCommand.Flags(), false)
+	editFlags.AddFlags(apiServerSourceCreateCommand)
+	return apiServerSourceCreateCommand
+}
@@ -0,0 +1,70 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/knative/client/pkg/eventing"
+)
+
+func TestApiServerSourceCreate(t *testing.T) {
+	fakeDynamic, output, err := fakeSource([]string{"source", "apiserver", "create", "foo",
+		"--resource", "Event:v1", "--resource", "Deployment:apps/v1:true", "--service-account", "sa", "--sink", "broker:default"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(output, "ApiServer source 'foo' successfully created in namespace 'default'.") {
+		t.Errorf("unexpected output: %s", output)
+	}
+	_, obj, err := getSource(fakeDynamic, apiServerSourceKind, "foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	source := obj.(*eventing.ApiServerSource)
+	expected := []eventing.ApiServerResource{
+		{APIVersion: "v1", Kind: "Event"},
+		{APIVersion: "apps/v1", Kind: "Deployment", Controller: true},
+	}
+	if !reflect.DeepEqual(source.Spec.Resources, expected) {
+		t.Errorf("got resources %v, expected %v", source.Spec.Resources, expected)
+	}
+	if source.Spec.Mode != eventing.ApiServerSourceModeRef || source.Spec.ServiceAccountName != "sa" {
+		t.Errorf("unexpected spec %v", source.Spec)
+	}
+	if sink := source.Spec.Sink; sink == nil || sink.Kind != "Broker" || sink.Name != "default" {
+		t.Errorf("unexpected sink %v", sink)
+	}
+}
+
+func TestApiServerSourceCreateErrors(t *testing.T) {
+	for _, tc := range []struct {
+		args     []string
+		expected string
+	}{
+		{[]string{"source", "apiserver", "create", "foo", "--sink", "svc:mysvc"}, "requires at least one resource to watch."},
+		{[]string{"source", "apiserver", "create", "foo", "--resource", "Event:v1"}, "requires the sink to send events to."},
+		{[]string{"source", "apiserver", "create", "foo", "--resource", "Event", "--sink", "svc:mysvc"}, "invalid resource 'Event'"},
+		{[]string{"source", "apiserver", "create", "foo", "--resource", "Pod:v1:yes", "--sink", "svc:mysvc"}, "CONTROLLER must be true or false"},
+		{[]string{"source", "apiserver", "create", "foo", "--resource", "Event:v1", "--mode", "Full", "--sink", "svc:mysvc"}, "invalid mode 'Full'"},
+	} {
+		_, _, err := fakeSource(tc.args)
+		if err == nil || !strings.Contains(err.Error(), tc.expected) {
+			t.Errorf("expected error '%s' for %v, got %v", tc.expected, tc.args, err)
+		}
+	}
+}
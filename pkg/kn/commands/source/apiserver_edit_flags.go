@@ -0,0 +1,101 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/knative/client/pkg/eventing"
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/spf13/cobra"
+)
+
+type ApiServerSourceEditFlags struct {
+	Resources      []string
+	Mode           string
+	ServiceAccount string
+	commands.SinkFlags
+}
+
+func (f *ApiServerSourceEditFlags) AddFlags(command *cobra.Command) {
+	command.Flags().StringArrayVar(&f.Resources, "resource", []string{},
+		"Resource to watch as KIND:APIVERSION[:CONTROLLER], e.g. 'Event:v1' or 'Pod:v1:true'. "+
+			"If CONTROLLER is true, events are sent for the controller of changed objects. "+
+			"You may provide this flag any number of times to watch multiple resources.")
+	command.Flags().StringVar(&f.Mode, "mode", eventing.ApiServerSourceModeRef,
+		"Whether events contain a reference to the changed object ('Ref') or the object itself ('Resource').")
+	command.Flags().StringVar(&f.ServiceAccount, "service-account", "",
+		"Service account the source runs with, which needs permissions to watch the resources.")
+	f.SinkFlags.AddReference(command)
+}
+
+// Apply the changed flags to the source, returning the names of the
+// changed spec fields. Resources given with --resource replace the
+// watched resources.
+func (f *ApiServerSourceEditFlags) Apply(source *eventing.ApiServerSource, cmd *cobra.Command) ([]string, error) {
+	var fields []string
+	if cmd.Flags().Changed("resource") {
+		resources, err := parseApiServerResources(f.Resources)
+		if err != nil {
+			return nil, err
+		}
+		source.Spec.Resources = resources
+		fields = append(fields, "resources")
+	}
+	// The mode is always set on creation, to its default if not given
+	if cmd.Flags().Changed("mode") || source.Spec.Mode == "" {
+		if f.Mode != eventing.ApiServerSourceModeRef && f.Mode != eventing.ApiServerSourceModeResource {
+			return nil, fmt.Errorf("invalid mode '%s', expected '%s' or '%s'",
+				f.Mode, eventing.ApiServerSourceModeRef, eventing.ApiServerSourceModeResource)
+		}
+		source.Spec.Mode = f.Mode
+		fields = append(fields, "mode")
+	}
+	if cmd.Flags().Changed("service-account") {
+		source.Spec.ServiceAccountName = f.ServiceAccount
+		fields = append(fields, "serviceAccountName")
+	}
+	if cmd.Flags().Changed("sink") {
+		sink, err := f.SinkFlags.Reference()
+		if err != nil {
+			return nil, err
+		}
+		source.Spec.Sink = sink
+		fields = append(fields, "sink")
+	}
+	return fields, nil
+}
+
+func parseApiServerResources(specs []string) ([]eventing.ApiServerResource, error) {
+	resources := make([]eventing.ApiServerResource, 0, len(specs))
+	for _, spec := range specs {
+		parts := strings.Split(spec, ":")
+		if len(parts) < 2 || len(parts) > 3 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid resource '%s', expected KIND:APIVERSION[:CONTROLLER]", spec)
+		}
+		resource := eventing.ApiServerResource{Kind: parts[0], APIVersion: parts[1]}
+		if len(parts) == 3 {
+			controller, err := strconv.ParseBool(parts[2])
+			if err != nil {
+				return nil, fmt.Errorf("invalid resource '%s', CONTROLLER must be true or false", spec)
+			}
+			resource.Controller = controller
+		}
+		resources = append(resources, resource)
+	}
+	return resources, nil
+}
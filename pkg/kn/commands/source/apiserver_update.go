@@ -0,0 +1,47 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source
+
+import (
+	"errors"
+
+	"github.com/knative/client/pkg/eventing"
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func NewApiServerSourceUpdateCommand(p *commands.KnParams) *cobra.Command {
+	var editFlags ApiServerSourceEditFlags
+
+	apiServerSourceUpdateCommand := &cobra.Command{
+		Use:   "update NAME",
+		Short: "Update an ApiServer source.",
+		Example: `
+  # Watch deployments instead of the previously watched resources
+  kn source apiserver update mysource --resource Deployment:apps/v1`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("requires the source name.")
+			}
+			return updateSource(p, cmd, apiServerSourceKind, args[0], func(source runtime.Object) ([]string, error) {
+				return editFlags.Apply(source.(*eventing.ApiServerSource), cmd)
+			})
+		},
+	}
+	commands.AddNamespaceFlags(apiServerSourceUpdateCommand.Flags(), false)
+	editFlags.AddFlags(apiServerSourceUpdateCommand)
+	return apiServerSourceUpdateCommand
+}
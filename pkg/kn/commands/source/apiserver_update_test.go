@@ -0,0 +1,46 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/knative/client/pkg/eventing"
+)
+
+func TestApiServerSourceUpdate(t *testing.T) {
+	fakeDynamic, _, err := fakeSource([]string{"source", "apiserver", "update", "foo",
+		"--resource", "Pod:v1", "--mode", "Resource"},
+		createMockApiServerSource("foo", eventing.ApiServerResource{APIVersion: "v1", Kind: "Event"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, obj, err := getSource(fakeDynamic, apiServerSourceKind, "foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	source := obj.(*eventing.ApiServerSource)
+	expected := []eventing.ApiServerResource{{APIVersion: "v1", Kind: "Pod"}}
+	if !reflect.DeepEqual(source.Spec.Resources, expected) {
+		t.Errorf("got resources %v, expected %v", source.Spec.Resources, expected)
+	}
+	if source.Spec.Mode != eventing.ApiServerSourceModeResource {
+		t.Errorf("unexpected mode %s", source.Spec.Mode)
+	}
+	if sink := source.Spec.Sink; sink == nil || sink.Kind != "Broker" {
+		t.Errorf("unexpected sink %v", sink)
+	}
+}
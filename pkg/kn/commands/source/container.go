@@ -0,0 +1,86 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/knative/client/pkg/eventing"
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Argument passing a sink URI to the container of a container source,
+// which is used if the source has no sink reference
+const containerSinkArg = "--sink="
+
+var containerSourceKind = &sourceKind{
+	name:      "container",
+	title:     "Container source",
+	kind:      "ContainerSource",
+	resource:  eventing.ContainerSourceResource,
+	newObject: func() runtime.Object { return &eventing.ContainerSource{} },
+	newList:   func() runtime.Object { return &eventing.ContainerSourceList{} },
+	describe:  describeContainerSource,
+}
+
+func NewContainerSourceCommand(p *commands.KnParams) *cobra.Command {
+	return newSourceKindCommand(p, containerSourceKind,
+		NewContainerSourceCreateCommand(p),
+		NewContainerSourceUpdateCommand(p))
+}
+
+func describeContainerSource(out io.Writer, obj runtime.Object) error {
+	source := obj.(*eventing.ContainerSource)
+	return describeSource(out, source, containerSinkValue(&source.Spec), &source.Status, func(field func(label, value string)) {
+		field("Image", source.Spec.Image)
+		field("Args", strings.Join(containerArgs(source.Spec.Args), " "))
+		env := make([]string, 0, len(source.Spec.Env))
+		for _, envVar := range source.Spec.Env {
+			env = append(env, fmt.Sprintf("%s=%s", envVar.Name, envVar.Value))
+		}
+		field("Env", strings.Join(env, ", "))
+		field("Service Account", source.Spec.ServiceAccountName)
+	})
+}
+
+// containerSinkValue formats the sink of a container source like the
+// --sink flag, taking the sink URI argument into account
+func containerSinkValue(spec *eventing.ContainerSourceSpec) string {
+	if spec.Sink != nil {
+		return sinkValue(spec.Sink)
+	}
+	for _, arg := range spec.Args {
+		if strings.HasPrefix(arg, containerSinkArg) {
+			return strings.TrimPrefix(arg, containerSinkArg)
+		}
+	}
+	return ""
+}
+
+// containerArgs returns the arguments of a container source without the
+// sink URI argument
+func containerArgs(args []string) []string {
+	result := make([]string, 0, len(args))
+	for _, arg := range args {
+		if !strings.HasPrefix(arg, containerSinkArg) {
+			result = append(result, arg)
+		}
+	}
+	return result
+}
@@ -0,0 +1,58 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source
+
+import (
+	"errors"
+
+	"github.com/knative/client/pkg/eventing"
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/spf13/cobra"
+)
+
+func NewContainerSourceCreateCommand(p *commands.KnParams) *cobra.Command {
+	var editFlags ContainerSourceEditFlags
+
+	containerSourceCreateCommand := &cobra.Command{
+		Use:   "create NAME --image IMAGE --sink SINK",
+		Short: "Create a Container source.",
+		Example: `
+  # Run the image 'heartbeats' sending events to the service 'mysvc'
+  kn source container create mysource --image gcr.io/knative-releases/heartbeats --arg=--period=5 --sink svc:mysvc
+
+  # Run the image 'heartbeats' sending events to a URI
+  kn source container create mysource --image gcr.io/knative-releases/heartbeats --sink http://example.com/events`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("requires the source name.")
+			}
+			if editFlags.Image == "" {
+				return errors.New("requires the image of the source.")
+			}
+			if editFlags.Sink == "" {
+				return errors.New("requires the sink to send events to.")
+			}
+			source := &eventing.ContainerSource{}
+			_, err := editFlags.Apply(source, cmd)
+			if err != nil {
+				return err
+			}
+			return createSource(p, cmd, containerSourceKind, args[0], source)
+		},
+	}
+	commands.AddNamespaceFlags(containerSourceCreateCommand.Flags(), false)
+	editFlags.AddFlags(containerSourceCreateCommand)
+	return containerSourceCreateCommand
+}
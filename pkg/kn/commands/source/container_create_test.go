@@ -0,0 +1,81 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/knative/client/pkg/eventing"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestContainerSourceCreate(t *testing.T) {
+	fakeDynamic, output, err := fakeSource([]string{"source", "container", "create", "foo",
+		"--image", "gcr.io/foo/heartbeats", "--arg=--period=5", "-e", "A=1", "--sink", "svc:mysvc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(output, "Container source 'foo' successfully created in namespace 'default'.") {
+		t.Errorf("unexpected output: %s", output)
+	}
+	_, obj, err := getSource(fakeDynamic, containerSourceKind, "foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	source := obj.(*eventing.ContainerSource)
+	if source.Spec.Image != "gcr.io/foo/heartbeats" || !reflect.DeepEqual(source.Spec.Args, []string{"--period=5"}) {
+		t.Errorf("unexpected spec %v", source.Spec)
+	}
+	if !reflect.DeepEqual(source.Spec.Env, []corev1.EnvVar{{Name: "A", Value: "1"}}) {
+		t.Errorf("unexpected env %v", source.Spec.Env)
+	}
+	if sink := source.Spec.Sink; sink == nil || sink.Kind != "Service" || sink.Name != "mysvc" {
+		t.Errorf("unexpected sink %v", sink)
+	}
+}
+
+func TestContainerSourceCreateSinkURI(t *testing.T) {
+	fakeDynamic, _, err := fakeSource([]string{"source", "container", "create", "foo",
+		"--image", "gcr.io/foo/heartbeats", "--sink", "http://example.com/events"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, obj, err := getSource(fakeDynamic, containerSourceKind, "foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	source := obj.(*eventing.ContainerSource)
+	if source.Spec.Sink != nil || !reflect.DeepEqual(source.Spec.Args, []string{"--sink=http://example.com/events"}) {
+		t.Errorf("unexpected spec %v", source.Spec)
+	}
+}
+
+func TestContainerSourceCreateErrors(t *testing.T) {
+	for _, tc := range []struct {
+		args     []string
+		expected string
+	}{
+		{[]string{"source", "container", "create", "foo", "--sink", "svc:mysvc"}, "requires the image of the source."},
+		{[]string{"source", "container", "create", "foo", "--image", "gcr.io/foo/heartbeats"}, "requires the sink to send events to."},
+		{[]string{"source", "container", "create", "foo", "--image", "gcr.io/foo/heartbeats", "-e", "A", "--sink", "svc:mysvc"}, "requires a value that contains the '=' character"},
+	} {
+		_, _, err := fakeSource(tc.args)
+		if err == nil || !strings.Contains(err.Error(), tc.expected) {
+			t.Errorf("expected error '%s' for %v, got %v", tc.expected, tc.args, err)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source
+
+import (
+	"github.com/knative/client/pkg/eventing"
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+)
+
+type ContainerSourceEditFlags struct {
+	Image          string
+	Args           []string
+	Env            []string
+	ServiceAccount string
+	commands.SinkFlags
+}
+
+func (f *ContainerSourceEditFlags) AddFlags(command *cobra.Command) {
+	command.Flags().StringVar(&f.Image, "image", "", "Image of the container producing events.")
+	command.Flags().StringArrayVar(&f.Args, "arg", []string{},
+		"Argument of the container; you may provide this flag any number of times to pass multiple arguments.")
+	command.Flags().StringArrayVarP(&f.Env, "env", "e", []string{},
+		"Environment variable to set. NAME=value; you may provide this flag "+
+			"any number of times to set multiple environment variables.")
+	command.Flags().StringVar(&f.ServiceAccount, "service-account", "",
+		"Service account the container runs with.")
+	f.SinkFlags.Add(command)
+}
+
+// Apply the changed flags to the source, returning the names of the
+// changed spec fields. Arguments and environment variables given with
+// --arg and --env replace the existing ones.
+func (f *ContainerSourceEditFlags) Apply(source *eventing.ContainerSource, cmd *cobra.Command) ([]string, error) {
+	var fields []string
+	if cmd.Flags().Changed("image") {
+		source.Spec.Image = f.Image
+		fields = append(fields, "image")
+	}
+	if cmd.Flags().Changed("arg") {
+		// A sink URI argument is kept unless the sink is changed too
+		args := f.Args
+		if sinkURI := containerSinkValue(&source.Spec); source.Spec.Sink == nil && sinkURI != "" {
+			args = append(args, containerSinkArg+sinkURI)
+		}
+		source.Spec.Args = args
+		fields = append(fields, "args")
+	}
+	if cmd.Flags().Changed("env") {
+		env, err := parseEnv(f.Env)
+		if err != nil {
+			return nil, err
+		}
+		source.Spec.Env = env
+		fields = append(fields, "env")
+	}
+	if cmd.Flags().Changed("service-account") {
+		source.Spec.ServiceAccountName = f.ServiceAccount
+		fields = append(fields, "serviceAccountName")
+	}
+	if cmd.Flags().Changed("sink") {
+		sink, err := f.SinkFlags.Parse()
+		if err != nil {
+			return nil, err
+		}
+		// Container sources receive sink URIs as argument
+		source.Spec.Sink = sink.Ref
+		source.Spec.Args = containerArgs(source.Spec.Args)
+		if sink.URI != nil {
+			source.Spec.Args = append(source.Spec.Args, containerSinkArg+*sink.URI)
+		}
+		fields = append(fields, "sink", "args")
+	}
+	return fields, nil
+}
+
+func parseEnv(pairs []string) ([]corev1.EnvVar, error) {
+	env := make([]corev1.EnvVar, 0, len(pairs))
+	for _, pairStr := range pairs {
+		name, value, err := commands.SplitKeyValue("env", pairStr)
+		if err != nil {
+			return nil, err
+		}
+		env = append(env, corev1.EnvVar{Name: name, Value: value})
+	}
+	return env, nil
+}
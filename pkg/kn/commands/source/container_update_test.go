@@ -0,0 +1,67 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/knative/client/pkg/eventing"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func fakeContainerSourceUpdate(t *testing.T, args []string, source *eventing.ContainerSource) *eventing.ContainerSource {
+	fakeDynamic, _, err := fakeSource(append([]string{"source", "container", "update", "foo"}, args...), source)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, obj, err := getSource(fakeDynamic, containerSourceKind, "foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return obj.(*eventing.ContainerSource)
+}
+
+func TestContainerSourceUpdateArgsKeepsSinkURI(t *testing.T) {
+	source := fakeContainerSourceUpdate(t, []string{"--arg=--period=10"},
+		createMockContainerSource("foo", "gcr.io/foo/heartbeats", "--period=5", "--sink=http://example.com"))
+	expected := []string{"--period=10", "--sink=http://example.com"}
+	if !reflect.DeepEqual(source.Spec.Args, expected) {
+		t.Errorf("got args %v, expected %v", source.Spec.Args, expected)
+	}
+}
+
+func TestContainerSourceUpdateSinkToReference(t *testing.T) {
+	source := fakeContainerSourceUpdate(t, []string{"--sink", "svc:mysvc"},
+		createMockContainerSource("foo", "gcr.io/foo/heartbeats", "--period=5", "--sink=http://example.com"))
+	if !reflect.DeepEqual(source.Spec.Args, []string{"--period=5"}) {
+		t.Errorf("unexpected args %v", source.Spec.Args)
+	}
+	if sink := source.Spec.Sink; sink == nil || sink.Kind != "Service" || sink.Name != "mysvc" {
+		t.Errorf("unexpected sink %v", sink)
+	}
+}
+
+func TestContainerSourceUpdateSinkToURI(t *testing.T) {
+	existing := createMockContainerSource("foo", "gcr.io/foo/heartbeats")
+	existing.Spec.Sink = &corev1.ObjectReference{APIVersion: "serving.knative.dev/v1alpha1", Kind: "Service", Name: "mysvc"}
+	source := fakeContainerSourceUpdate(t, []string{"--sink", "https://example.com", "--image", "gcr.io/foo/heartbeats:v2"}, existing)
+	if source.Spec.Sink != nil || !reflect.DeepEqual(source.Spec.Args, []string{"--sink=https://example.com"}) {
+		t.Errorf("unexpected spec %v", source.Spec)
+	}
+	if source.Spec.Image != "gcr.io/foo/heartbeats:v2" {
+		t.Errorf("unexpected image %s", source.Spec.Image)
+	}
+}
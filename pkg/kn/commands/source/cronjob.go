@@ -0,0 +1,49 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source
+
+import (
+	"io"
+
+	"github.com/knative/client/pkg/eventing"
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var cronJobSourceKind = &sourceKind{
+	name:      "cronjob",
+	title:     "CronJob source",
+	kind:      "CronJobSource",
+	resource:  eventing.CronJobSourceResource,
+	newObject: func() runtime.Object { return &eventing.CronJobSource{} },
+	newList:   func() runtime.Object { return &eventing.CronJobSourceList{} },
+	describe:  describeCronJobSource,
+}
+
+func NewCronJobSourceCommand(p *commands.KnParams) *cobra.Command {
+	return newSourceKindCommand(p, cronJobSourceKind,
+		NewCronJobSourceCreateCommand(p),
+		NewCronJobSourceUpdateCommand(p))
+}
+
+func describeCronJobSource(out io.Writer, obj runtime.Object) error {
+	source := obj.(*eventing.CronJobSource)
+	return describeSource(out, source, sinkValue(source.Spec.Sink), &source.Status, func(field func(label, value string)) {
+		field("Schedule", source.Spec.Schedule)
+		field("Data", source.Spec.Data)
+		field("Service Account", source.Spec.ServiceAccountName)
+	})
+}
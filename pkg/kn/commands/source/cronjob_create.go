@@ -0,0 +1,55 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source
+
+import (
+	"errors"
+
+	"github.com/knative/client/pkg/eventing"
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/spf13/cobra"
+)
+
+func NewCronJobSourceCreateCommand(p *commands.KnParams) *cobra.Command {
+	var editFlags CronJobSourceEditFlags
+
+	cronJobSourceCreateCommand := &cobra.Command{
+		Use:   "create NAME --schedule SCHEDULE --sink SINK",
+		Short: "Create a CronJob source.",
+		Example: `
+  # Send an event with the data '{"message": "Hello"}' to the service 'mysvc' every minute
+  kn source cronjob create mysource --schedule "* * * * *" --data '{"message": "Hello"}' --sink svc:mysvc`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("requires the source name.")
+			}
+			if editFlags.Schedule == "" {
+				return errors.New("requires the schedule of the source.")
+			}
+			if editFlags.Sink == "" {
+				return errors.New("requires the sink to send events to.")
+			}
+			source := &eventing.CronJobSource{}
+			_, err := editFlags.Apply(source, cmd)
+			if err != nil {
+				return err
+			}
+			return createSource(p, cmd, cronJobSourceKind, args[0], source)
+		},
+	}
+	commands.AddNamespaceFlags(cronJobSourceCreateCommand.Flags(), false)
+	editFlags.AddFlags(cronJobSourceCreateCommand)
+	return cronJobSourceCreateCommand
+}
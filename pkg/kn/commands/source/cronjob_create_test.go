@@ -0,0 +1,64 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/knative/client/pkg/eventing"
+)
+
+func TestCronJobSourceCreate(t *testing.T) {
+	fakeDynamic, output, err := fakeSource([]string{"source", "cronjob", "create", "foo",
+		"--schedule", "* * * * *", "--data", "hello", "--service-account", "sa", "--sink", "svc:mysvc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(output, "CronJob source 'foo' successfully created in namespace 'default'.") {
+		t.Errorf("unexpected output: %s", output)
+	}
+	_, obj, err := getSource(fakeDynamic, cronJobSourceKind, "foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	source := obj.(*eventing.CronJobSource)
+	if source.Kind != "CronJobSource" || source.APIVersion != "sources.eventing.knative.dev/v1alpha1" {
+		t.Errorf("unexpected type %v", source.TypeMeta)
+	}
+	if source.Spec.Schedule != "* * * * *" || source.Spec.Data != "hello" || source.Spec.ServiceAccountName != "sa" {
+		t.Errorf("unexpected spec %v", source.Spec)
+	}
+	if sink := source.Spec.Sink; sink == nil || sink.Kind != "Service" || sink.Name != "mysvc" {
+		t.Errorf("unexpected sink %v", sink)
+	}
+}
+
+func TestCronJobSourceCreateErrors(t *testing.T) {
+	for _, tc := range []struct {
+		args     []string
+		expected string
+	}{
+		{[]string{"source", "cronjob", "create", "--schedule", "* * * * *", "--sink", "svc:mysvc"}, "requires the source name."},
+		{[]string{"source", "cronjob", "create", "foo", "--sink", "svc:mysvc"}, "requires the schedule of the source."},
+		{[]string{"source", "cronjob", "create", "foo", "--schedule", "* * * * *"}, "requires the sink to send events to."},
+		{[]string{"source", "cronjob", "create", "foo", "--schedule", "* * * * *", "--sink", "http://example.com"}, "is no object reference"},
+	} {
+		_, _, err := fakeSource(tc.args)
+		if err == nil || !strings.Contains(err.Error(), tc.expected) {
+			t.Errorf("expected error '%s' for %v, got %v", tc.expected, tc.args, err)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source
+
+import (
+	"github.com/knative/client/pkg/eventing"
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/spf13/cobra"
+)
+
+type CronJobSourceEditFlags struct {
+	Schedule       string
+	Data           string
+	ServiceAccount string
+	commands.SinkFlags
+}
+
+func (f *CronJobSourceEditFlags) AddFlags(command *cobra.Command) {
+	command.Flags().StringVar(&f.Schedule, "schedule", "",
+		"Schedule in cron format, e.g. '*/2 * * * *' for every two minutes.")
+	command.Flags().StringVar(&f.Data, "data", "", "Data sent with each event.")
+	command.Flags().StringVar(&f.ServiceAccount, "service-account", "",
+		"Service account the source runs with.")
+	f.SinkFlags.AddReference(command)
+}
+
+// Apply the changed flags to the source, returning the names of the
+// changed spec fields
+func (f *CronJobSourceEditFlags) Apply(source *eventing.CronJobSource, cmd *cobra.Command) ([]string, error) {
+	var fields []string
+	if cmd.Flags().Changed("schedule") {
+		source.Spec.Schedule = f.Schedule
+		fields = append(fields, "schedule")
+	}
+	if cmd.Flags().Changed("data") {
+		source.Spec.Data = f.Data
+		fields = append(fields, "data")
+	}
+	if cmd.Flags().Changed("service-account") {
+		source.Spec.ServiceAccountName = f.ServiceAccount
+		fields = append(fields, "serviceAccountName")
+	}
+	if cmd.Flags().Changed("sink") {
+		sink, err := f.SinkFlags.Reference()
+		if err != nil {
+			return nil, err
+		}
+		source.Spec.Sink = sink
+		fields = append(fields, "sink")
+	}
+	return fields, nil
+}
@@ -0,0 +1,74 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/knative/client/pkg/eventing"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestCronJobSourceUpdate(t *testing.T) {
+	fakeDynamic, output, err := fakeSource([]string{"source", "cronjob", "update", "foo",
+		"--schedule", "*/2 * * * *", "--sink", "broker:default"}, createMockCronJobSource("foo", "* * * * *"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(output, "CronJob source 'foo' successfully updated in namespace 'default'.") {
+		t.Errorf("unexpected output: %s", output)
+	}
+	_, obj, err := getSource(fakeDynamic, cronJobSourceKind, "foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	source := obj.(*eventing.CronJobSource)
+	if source.Spec.Schedule != "*/2 * * * *" || source.Spec.Data != `{"message": "Hello"}` {
+		t.Errorf("unexpected spec %v", source.Spec)
+	}
+	if sink := source.Spec.Sink; sink == nil || sink.Kind != "Broker" || sink.Name != "default" {
+		t.Errorf("unexpected sink %v", sink)
+	}
+}
+
+func TestCronJobSourceUpdateKeepsUnknownFields(t *testing.T) {
+	source, err := eventing.ToUnstructured(createMockCronJobSource("foo", "* * * * *"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	unstructured.SetNestedField(source.Object, "100m", "spec", "resources", "requests", "resourceCpu")
+	fakeDynamic, _, err := fakeSource([]string{"source", "cronjob", "update", "foo", "--data", "bye"}, source)
+	if err != nil {
+		t.Fatal(err)
+	}
+	obj, _, err := getSource(fakeDynamic, cronJobSourceKind, "foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cpu, _, _ := unstructured.NestedString(obj.Object, "spec", "resources", "requests", "resourceCpu"); cpu != "100m" {
+		t.Error("unknown field not preserved")
+	}
+	if data, _, _ := unstructured.NestedString(obj.Object, "spec", "data"); data != "bye" {
+		t.Errorf("unexpected data %s", data)
+	}
+}
+
+func TestCronJobSourceUpdateNothing(t *testing.T) {
+	_, _, err := fakeSource([]string{"source", "cronjob", "update", "foo"}, createMockCronJobSource("foo", "* * * * *"))
+	if err == nil || err.Error() != "requires at least one flag to update." {
+		t.Errorf("expected error for missing update, got %v", err)
+	}
+}
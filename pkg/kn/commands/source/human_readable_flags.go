@@ -0,0 +1,125 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source
+
+import (
+	"github.com/knative/client/pkg/eventing"
+	"github.com/knative/client/pkg/kn/commands"
+	hprinters "github.com/knative/client/pkg/printers"
+	duckv1beta1 "github.com/knative/pkg/apis/duck/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// SourceGetHandlers adds print handlers for the get commands of all
+// kinds of sources
+func SourceGetHandlers(h hprinters.PrintHandler) {
+	cronJobSourceColumnDefinitions := sourceColumnDefinitions(
+		metav1beta1.TableColumnDefinition{Name: "Schedule", Type: "string", Description: "Schedule of the source."})
+	h.TableHandler(cronJobSourceColumnDefinitions, printCronJobSource)
+	h.TableHandler(cronJobSourceColumnDefinitions, printCronJobSourceList)
+
+	apiServerSourceColumnDefinitions := sourceColumnDefinitions(
+		metav1beta1.TableColumnDefinition{Name: "Resources", Type: "string", Description: "Resources watched by the source."})
+	h.TableHandler(apiServerSourceColumnDefinitions, printApiServerSource)
+	h.TableHandler(apiServerSourceColumnDefinitions, printApiServerSourceList)
+
+	containerSourceColumnDefinitions := sourceColumnDefinitions(
+		metav1beta1.TableColumnDefinition{Name: "Image", Type: "string", Description: "Image of the source."})
+	h.TableHandler(containerSourceColumnDefinitions, printContainerSource)
+	h.TableHandler(containerSourceColumnDefinitions, printContainerSourceList)
+}
+
+// Private functions
+
+// sourceColumnDefinitions returns the columns of a kind of source, with
+// the given column specific to the kind following the name
+func sourceColumnDefinitions(column metav1beta1.TableColumnDefinition) []metav1beta1.TableColumnDefinition {
+	return []metav1beta1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Description: "Name of the source."},
+		column,
+		{Name: "Sink", Type: "string", Description: "Sink events are sent to."},
+		{Name: "Age", Type: "string", Description: "Age of the source."},
+		{Name: "Conditions", Type: "string", Description: "Conditions describing statuses of source components."},
+		{Name: "Ready", Type: "string", Description: "Ready condition status of the source."},
+		{Name: "Reason", Type: "string", Description: "Reason for non-ready condition of the source."},
+	}
+}
+
+// sourceRow populates a source table row with the given value of the
+// column specific to the kind
+func sourceRow(source runtime.Object, meta metav1.ObjectMeta, value, sink string, conditions duckv1beta1.Conditions) []metav1beta1.TableRow {
+	row := metav1beta1.TableRow{
+		Object: runtime.RawExtension{Object: source},
+	}
+	row.Cells = append(row.Cells,
+		meta.Name,
+		value,
+		sink,
+		commands.TranslateTimestampSince(meta.CreationTimestamp),
+		commands.ConditionsValue(conditions),
+		commands.ReadyCondition(conditions),
+		commands.NonReadyConditionReason(conditions))
+	return []metav1beta1.TableRow{row}
+}
+
+func printCronJobSourceList(sourceList *eventing.CronJobSourceList, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
+	rows := make([]metav1beta1.TableRow, 0, len(sourceList.Items))
+	for i := range sourceList.Items {
+		r, err := printCronJobSource(&sourceList.Items[i], options)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, r...)
+	}
+	return rows, nil
+}
+
+func printCronJobSource(source *eventing.CronJobSource, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
+	return sourceRow(source, source.ObjectMeta, source.Spec.Schedule, sinkValue(source.Spec.Sink), source.Status.Conditions), nil
+}
+
+func printApiServerSourceList(sourceList *eventing.ApiServerSourceList, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
+	rows := make([]metav1beta1.TableRow, 0, len(sourceList.Items))
+	for i := range sourceList.Items {
+		r, err := printApiServerSource(&sourceList.Items[i], options)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, r...)
+	}
+	return rows, nil
+}
+
+func printApiServerSource(source *eventing.ApiServerSource, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
+	return sourceRow(source, source.ObjectMeta, apiServerResourcesValue(source.Spec.Resources), sinkValue(source.Spec.Sink), source.Status.Conditions), nil
+}
+
+func printContainerSourceList(sourceList *eventing.ContainerSourceList, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
+	rows := make([]metav1beta1.TableRow, 0, len(sourceList.Items))
+	for i := range sourceList.Items {
+		r, err := printContainerSource(&sourceList.Items[i], options)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, r...)
+	}
+	return rows, nil
+}
+
+func printContainerSource(source *eventing.ContainerSource, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
+	return sourceRow(source, source.ObjectMeta, source.Spec.Image, containerSinkValue(&source.Spec), source.Status.Conditions), nil
+}
@@ -0,0 +1,66 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source
+
+import (
+	"io"
+
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func NewSourceCommand(p *commands.KnParams) *cobra.Command {
+	sourceCmd := &cobra.Command{
+		Use:   "source",
+		Short: "Event source command group",
+	}
+	sourceCmd.AddCommand(NewCronJobSourceCommand(p))
+	sourceCmd.AddCommand(NewApiServerSourceCommand(p))
+	sourceCmd.AddCommand(NewContainerSourceCommand(p))
+	return sourceCmd
+}
+
+// sourceKind describes a kind of event source, for the commands all kinds
+// have in common
+type sourceKind struct {
+	// Name of the command group, e.g. 'cronjob'
+	name string
+	// Name of the kind used in messages, e.g. 'CronJob source'
+	title string
+	// Kind of the source object, e.g. 'CronJobSource'
+	kind     string
+	resource schema.GroupVersionResource
+
+	newObject func() runtime.Object
+	newList   func() runtime.Object
+	describe  func(out io.Writer, source runtime.Object) error
+}
+
+// Create the command group of a kind of source, with the given create and
+// update commands
+func newSourceKindCommand(p *commands.KnParams, kind *sourceKind, create, update *cobra.Command) *cobra.Command {
+	kindCmd := &cobra.Command{
+		Use:   kind.name,
+		Short: kind.title + " command group",
+	}
+	kindCmd.AddCommand(create)
+	kindCmd.AddCommand(update)
+	kindCmd.AddCommand(newSourceDeleteCommand(p, kind))
+	kindCmd.AddCommand(newSourceGetCommand(p, kind))
+	kindCmd.AddCommand(newSourceDescribeCommand(p, kind))
+	return kindCmd
+}
@@ -0,0 +1,41 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSourceDelete(t *testing.T) {
+	fakeDynamic, output, err := fakeSource([]string{"source", "apiserver", "delete", "foo"}, createMockApiServerSource("foo"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(output, "ApiServer source 'foo' successfully deleted in namespace 'default'.") {
+		t.Errorf("unexpected output: %s", output)
+	}
+	_, _, err = getSource(fakeDynamic, apiServerSourceKind, "foo")
+	if err == nil {
+		t.Error("expected source to be deleted")
+	}
+}
+
+func TestSourceDeleteNotFound(t *testing.T) {
+	_, _, err := fakeSource([]string{"source", "cronjob", "delete", "foo"})
+	if err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
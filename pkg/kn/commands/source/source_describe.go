@@ -0,0 +1,104 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/knative/client/pkg/eventing"
+	"github.com/knative/client/pkg/kn/commands"
+	hprinters "github.com/knative/client/pkg/printers"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+func newSourceDescribeCommand(p *commands.KnParams, kind *sourceKind) *cobra.Command {
+	sourceDescribePrintFlags := genericclioptions.NewPrintFlags("")
+	sourceDescribeCommand := &cobra.Command{
+		Use:   "describe NAME",
+		Short: fmt.Sprintf("Describe a %s.", kind.title),
+		Example: fmt.Sprintf(`
+  # Describe the %s 'mysource' in the default namespace
+  kn source %s describe mysource
+
+  # Print the %s 'mysource' as YAML
+  kn source %s describe mysource -o yaml`, kind.title, kind.name, kind.title, kind.name),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("requires the source name.")
+			}
+			namespace, err := commands.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.DynamicFactory()
+			if err != nil {
+				return err
+			}
+			obj, err := client.Resource(kind.resource).Namespace(namespace).Get(args[0], metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			source := kind.newObject()
+			err = eventing.FromUnstructured(obj, source)
+			if err != nil {
+				return err
+			}
+
+			if sourceDescribePrintFlags.OutputFlagSpecified() {
+				printer, err := sourceDescribePrintFlags.ToPrinter()
+				if err != nil {
+					return err
+				}
+				source.GetObjectKind().SetGroupVersionKind(eventing.SourcesSchemeGroupVersion.WithKind(kind.kind))
+				return printer.PrintObj(source, cmd.OutOrStdout())
+			}
+			return kind.describe(cmd.OutOrStdout(), source)
+		},
+	}
+	commands.AddNamespaceFlags(sourceDescribeCommand.Flags(), false)
+	sourceDescribePrintFlags.AddFlags(sourceDescribeCommand)
+	return sourceDescribeCommand
+}
+
+// describeSource prints the fields of a source with the given field
+// function, followed by its sink and conditions
+func describeSource(out io.Writer, source metav1.Object, sink string, status *eventing.SourceStatus, fields func(field func(label, value string))) error {
+	w := hprinters.GetNewTabWriter(out)
+	field := commands.FieldPrinter(w)
+	field("Name", source.GetName())
+	field("Namespace", source.GetNamespace())
+	field("Age", commands.TranslateTimestampSince(source.GetCreationTimestamp()))
+	fields(field)
+	field("Sink", sink)
+	field("Sink URI", status.SinkURI)
+	err := w.Flush()
+	if err != nil {
+		return err
+	}
+	return commands.PrintConditions(out, status.Conditions)
+}
+
+// sinkValue formats the sink reference of a source like the --sink flag
+func sinkValue(sink *corev1.ObjectReference) string {
+	if sink == nil {
+		return ""
+	}
+	return eventing.FormatSink(&eventing.SubscriberSpec{Ref: sink})
+}
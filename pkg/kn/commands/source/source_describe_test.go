@@ -0,0 +1,74 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/knative/client/pkg/eventing"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestCronJobSourceDescribe(t *testing.T) {
+	_, output, err := fakeSource([]string{"source", "cronjob", "describe", "foo"}, createMockCronJobSource("foo", "* * * * *"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	testContains(t, output, []string{
+		"Name:", "foo",
+		"Schedule:", "* * * * *",
+		"Data:", `{"message": "Hello"}`,
+		"Sink:", "svc:mysvc",
+		"Sink URI:", "http://mysvc.default.example.com",
+		"Conditions:", "Ready", "True",
+	}, "value")
+}
+
+func TestApiServerSourceDescribe(t *testing.T) {
+	_, output, err := fakeSource([]string{"source", "apiserver", "describe", "foo"},
+		createMockApiServerSource("foo", eventing.ApiServerResource{APIVersion: "v1", Kind: "Event"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	testContains(t, output, []string{"Resources:", "Event:v1", "Mode:", "Ref", "Sink:", "broker:default", "NotAuthorized"}, "value")
+}
+
+func TestContainerSourceDescribe(t *testing.T) {
+	source := createMockContainerSource("foo", "gcr.io/foo/heartbeats", "--period=5")
+	source.Spec.Sink = &corev1.ObjectReference{APIVersion: "serving.knative.dev/v1alpha1", Kind: "Service", Name: "mysvc"}
+	source.Spec.Env = []corev1.EnvVar{{Name: "A", Value: "1"}}
+	_, output, err := fakeSource([]string{"source", "container", "describe", "foo"}, source)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testContains(t, output, []string{"Image:", "gcr.io/foo/heartbeats", "Args:", "--period=5", "Env:", "A=1", "Sink:", "svc:mysvc"}, "value")
+}
+
+func TestSourceDescribeYAML(t *testing.T) {
+	_, output, err := fakeSource([]string{"source", "container", "describe", "foo", "-o", "yaml"},
+		createMockContainerSource("foo", "gcr.io/foo/heartbeats"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	testContains(t, output, []string{"kind: ContainerSource", "image: gcr.io/foo/heartbeats"}, "value")
+}
+
+func TestSourceDescribeNoName(t *testing.T) {
+	_, _, err := fakeSource([]string{"source", "cronjob", "describe"})
+	if err == nil || !strings.Contains(err.Error(), "requires the source name.") {
+		t.Errorf("expected error for missing name, got %v", err)
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/knative/client/pkg/eventing"
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// createSource creates the given source in the namespace of the command
+func createSource(p *commands.KnParams, cmd *cobra.Command, kind *sourceKind, name string, source runtime.Object) error {
+	namespace, err := commands.GetNamespace(cmd)
+	if err != nil {
+		return err
+	}
+	accessor, err := meta.Accessor(source)
+	if err != nil {
+		return err
+	}
+	accessor.SetName(name)
+	accessor.SetNamespace(namespace)
+	source.GetObjectKind().SetGroupVersionKind(eventing.SourcesSchemeGroupVersion.WithKind(kind.kind))
+
+	client, err := p.DynamicFactory()
+	if err != nil {
+		return err
+	}
+	obj, err := eventing.ToUnstructured(source)
+	if err != nil {
+		return err
+	}
+	_, err = client.Resource(kind.resource).Namespace(namespace).Create(obj, metav1.CreateOptions{})
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "%s '%s' successfully created in namespace '%s'.\n", kind.title, name, namespace)
+	return nil
+}
+
+// updateSource updates the source with the given name. The apply function
+// changes the typed source and returns the names of the changed spec
+// fields, which are the only ones written back so that fields unknown to
+// kn are kept.
+func updateSource(p *commands.KnParams, cmd *cobra.Command, kind *sourceKind, name string, apply func(source runtime.Object) ([]string, error)) error {
+	namespace, err := commands.GetNamespace(cmd)
+	if err != nil {
+		return err
+	}
+	client, err := p.DynamicFactory()
+	if err != nil {
+		return err
+	}
+	sources := client.Resource(kind.resource).Namespace(namespace)
+	obj, err := sources.Get(name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	source := kind.newObject()
+	err = eventing.FromUnstructured(obj, source)
+	if err != nil {
+		return err
+	}
+	fields, err := apply(source)
+	if err != nil {
+		return err
+	}
+	if len(fields) == 0 {
+		return errors.New("requires at least one flag to update.")
+	}
+	err = eventing.UpdateSpecFields(obj, source, fields...)
+	if err != nil {
+		return err
+	}
+	_, err = sources.Update(obj, metav1.UpdateOptions{})
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "%s '%s' successfully updated in namespace '%s'.\n", kind.title, name, namespace)
+	return nil
+}
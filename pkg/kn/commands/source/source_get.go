@@ -0,0 +1,77 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source
+
+import (
+	"fmt"
+
+	"github.com/knative/client/pkg/eventing"
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newSourceGetCommand(p *commands.KnParams, kind *sourceKind) *cobra.Command {
+	sourceGetFlags := NewSourceGetFlags()
+
+	sourceGetCommand := &cobra.Command{
+		Use:   "get",
+		Short: fmt.Sprintf("Get available %ss.", kind.title),
+		Example: fmt.Sprintf(`
+  # Get all %ss in the default namespace
+  kn source %s get
+
+  # Get all %ss in all namespaces as YAML
+  kn source %s get --all-namespaces -o yaml`, kind.title, kind.name, kind.title, kind.name),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			namespace, err := commands.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.DynamicFactory()
+			if err != nil {
+				return err
+			}
+			list, err := client.Resource(kind.resource).Namespace(namespace).List(metav1.ListOptions{})
+			if err != nil {
+				return err
+			}
+			sources := kind.newList()
+			err = eventing.FromUnstructured(list, sources)
+			if err != nil {
+				return err
+			}
+			items, err := meta.ExtractList(sources)
+			if err != nil {
+				return err
+			}
+			if len(items) == 0 {
+				fmt.Fprintf(cmd.OutOrStdout(), "No resources found.\n")
+				return nil
+			}
+			sources.GetObjectKind().SetGroupVersionKind(eventing.SourcesSchemeGroupVersion.WithKind(kind.kind + "List"))
+
+			printer, err := sourceGetFlags.ToPrinter()
+			if err != nil {
+				return err
+			}
+			return printer.PrintObj(sources, cmd.OutOrStdout())
+		},
+	}
+	commands.AddNamespaceFlags(sourceGetCommand.Flags(), true)
+	sourceGetFlags.AddFlags(sourceGetCommand)
+	return sourceGetCommand
+}
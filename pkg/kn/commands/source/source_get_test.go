@@ -0,0 +1,192 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/knative/client/pkg/eventing"
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/knative/pkg/apis"
+	duckv1beta1 "github.com/knative/pkg/apis/duck/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	client_testing "k8s.io/client-go/testing"
+)
+
+// fakeSource runs a source command against a fake dynamic client
+// containing the given sources
+func fakeSource(args []string, sources ...runtime.Object) (fakeDynamic *dynamicfake.FakeDynamicClient, output string, err error) {
+	knParams := &commands.KnParams{}
+	objects := make([]runtime.Object, 0, len(sources))
+	for _, source := range sources {
+		obj, err := eventing.ToUnstructured(source)
+		if err != nil {
+			return nil, "", err
+		}
+		objects = append(objects, obj)
+	}
+	cmd, _, fakeDynamic, buf := commands.CreateTestKnCommandWithDynamic(NewSourceCommand(knParams), knParams, objects...)
+	fakeDynamic.PrependReactor("list", "*",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			list := &unstructured.UnstructuredList{}
+			for _, obj := range objects {
+				item := obj.(*unstructured.Unstructured)
+				if strings.ToLower(item.GetKind())+"s" == a.GetResource().Resource {
+					list.Items = append(list.Items, *item)
+				}
+			}
+			return true, list, nil
+		})
+	cmd.SetArgs(args)
+	err = cmd.Execute()
+	output = buf.String()
+	return
+}
+
+func getSource(fakeDynamic *dynamicfake.FakeDynamicClient, kind *sourceKind, name string) (*unstructured.Unstructured, runtime.Object, error) {
+	obj, err := fakeDynamic.Resource(kind.resource).Namespace("default").Get(name, metav1.GetOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+	source := kind.newObject()
+	err = eventing.FromUnstructured(obj, source)
+	return obj, source, err
+}
+
+func testContains(t *testing.T, output string, sub []string, element string) {
+	for _, each := range sub {
+		if !strings.Contains(output, each) {
+			t.Errorf("Missing %s: %s", element, each)
+		}
+	}
+}
+
+func readyStatus(status corev1.ConditionStatus, reason string) eventing.SourceStatus {
+	return eventing.SourceStatus{
+		Status: duckv1beta1.Status{
+			Conditions: duckv1beta1.Conditions{
+				apis.Condition{Type: apis.ConditionReady, Status: status, Reason: reason},
+			},
+		},
+		SinkURI: "http://mysvc.default.example.com",
+	}
+}
+
+func sourceMeta(kind *sourceKind, name string) (metav1.TypeMeta, metav1.ObjectMeta) {
+	return metav1.TypeMeta{
+		APIVersion: eventing.SourcesSchemeGroupVersion.String(),
+		Kind:       kind.kind,
+	}, metav1.ObjectMeta{
+		Name:      name,
+		Namespace: "default",
+	}
+}
+
+func createMockCronJobSource(name, schedule string) *eventing.CronJobSource {
+	source := &eventing.CronJobSource{
+		Spec: eventing.CronJobSourceSpec{
+			Schedule: schedule,
+			Data:     `{"message": "Hello"}`,
+			Sink:     &corev1.ObjectReference{APIVersion: "serving.knative.dev/v1alpha1", Kind: "Service", Name: "mysvc"},
+		},
+		Status: readyStatus(corev1.ConditionTrue, ""),
+	}
+	source.TypeMeta, source.ObjectMeta = sourceMeta(cronJobSourceKind, name)
+	return source
+}
+
+func createMockApiServerSource(name string, resources ...eventing.ApiServerResource) *eventing.ApiServerSource {
+	source := &eventing.ApiServerSource{
+		Spec: eventing.ApiServerSourceSpec{
+			Resources: resources,
+			Mode:      eventing.ApiServerSourceModeRef,
+			Sink:      &corev1.ObjectReference{APIVersion: "eventing.knative.dev/v1alpha1", Kind: "Broker", Name: "default"},
+		},
+		Status: readyStatus(corev1.ConditionFalse, "NotAuthorized"),
+	}
+	source.TypeMeta, source.ObjectMeta = sourceMeta(apiServerSourceKind, name)
+	return source
+}
+
+func createMockContainerSource(name, image string, args ...string) *eventing.ContainerSource {
+	source := &eventing.ContainerSource{
+		Spec: eventing.ContainerSourceSpec{
+			Image: image,
+			Args:  args,
+		},
+		Status: readyStatus(corev1.ConditionTrue, ""),
+	}
+	source.TypeMeta, source.ObjectMeta = sourceMeta(containerSourceKind, name)
+	return source
+}
+
+func TestSourceGetEmpty(t *testing.T) {
+	_, output, err := fakeSource([]string{"source", "cronjob", "get"}, createMockApiServerSource("foo"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if output != "No resources found.\n" {
+		t.Errorf("unexpected output: %s", output)
+	}
+}
+
+func TestCronJobSourceGet(t *testing.T) {
+	_, output, err := fakeSource([]string{"source", "cronjob", "get"},
+		createMockCronJobSource("foo", "* * * * *"), createMockCronJobSource("bar", "*/2 * * * *"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(output, "\n")
+	testContains(t, lines[0], []string{"NAME", "SCHEDULE", "SINK", "AGE", "CONDITIONS", "READY", "REASON"}, "column header")
+	testContains(t, lines[1], []string{"foo", "* * * * *", "svc:mysvc", "1 OK / 1", "True"}, "value")
+	testContains(t, lines[2], []string{"bar", "*/2 * * * *", "svc:mysvc"}, "value")
+}
+
+func TestApiServerSourceGet(t *testing.T) {
+	_, output, err := fakeSource([]string{"source", "apiserver", "get"},
+		createMockApiServerSource("foo",
+			eventing.ApiServerResource{APIVersion: "v1", Kind: "Event"},
+			eventing.ApiServerResource{APIVersion: "v1", Kind: "Pod", Controller: true}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(output, "\n")
+	testContains(t, lines[0], []string{"NAME", "RESOURCES", "SINK", "READY", "REASON"}, "column header")
+	testContains(t, lines[1], []string{"foo", "Event:v1, Pod:v1:true", "broker:default", "False", "NotAuthorized"}, "value")
+}
+
+func TestContainerSourceGet(t *testing.T) {
+	_, output, err := fakeSource([]string{"source", "container", "get"},
+		createMockContainerSource("foo", "gcr.io/foo/heartbeats", "--period=5", "--sink=http://example.com"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(output, "\n")
+	testContains(t, lines[0], []string{"NAME", "IMAGE", "SINK", "READY"}, "column header")
+	testContains(t, lines[1], []string{"foo", "gcr.io/foo/heartbeats", "http://example.com", "True"}, "value")
+}
+
+func TestSourceGetYAML(t *testing.T) {
+	_, output, err := fakeSource([]string{"source", "cronjob", "get", "-o", "yaml"}, createMockCronJobSource("foo", "* * * * *"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	testContains(t, output, []string{"kind: CronJobSourceList", "name: foo", "schedule: '* * * * *'"}, "value")
+}
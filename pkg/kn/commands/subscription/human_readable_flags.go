@@ -0,0 +1,72 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subscription
+
+import (
+	"github.com/knative/client/pkg/eventing"
+	"github.com/knative/client/pkg/kn/commands"
+	hprinters "github.com/knative/client/pkg/printers"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// SubscriptionGetHandlers adds print handlers for subscription get command
+func SubscriptionGetHandlers(h hprinters.PrintHandler) {
+	subscriptionColumnDefinitions := []metav1beta1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Description: "Name of the subscription."},
+		{Name: "Channel", Type: "string", Description: "Channel the subscription subscribes to."},
+		{Name: "Subscriber", Type: "string", Description: "Subscriber events are sent to."},
+		{Name: "Reply", Type: "string", Description: "Destination replies of the subscriber are sent to."},
+		{Name: "Age", Type: "string", Description: "Age of the subscription."},
+		{Name: "Conditions", Type: "string", Description: "Conditions describing statuses of subscription components."},
+		{Name: "Ready", Type: "string", Description: "Ready condition status of the subscription."},
+		{Name: "Reason", Type: "string", Description: "Reason for non-ready condition of the subscription."},
+	}
+	h.TableHandler(subscriptionColumnDefinitions, printSubscription)
+	h.TableHandler(subscriptionColumnDefinitions, printSubscriptionList)
+}
+
+// Private functions
+
+// printSubscriptionList populates the subscription list table rows
+func printSubscriptionList(subscriptionList *eventing.SubscriptionList, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
+	rows := make([]metav1beta1.TableRow, 0, len(subscriptionList.Items))
+	for _, subscription := range subscriptionList.Items {
+		r, err := printSubscription(&subscription, options)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, r...)
+	}
+	return rows, nil
+}
+
+// printSubscription populates the subscription table rows
+func printSubscription(subscription *eventing.Subscription, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
+	channel := subscription.Spec.Channel
+	row := metav1beta1.TableRow{
+		Object: runtime.RawExtension{Object: subscription},
+	}
+	row.Cells = append(row.Cells,
+		subscription.Name,
+		channel.Kind+":"+channel.Name,
+		eventing.FormatSink(subscription.Spec.Subscriber),
+		eventing.FormatSink(subscription.Spec.Reply),
+		commands.TranslateTimestampSince(subscription.CreationTimestamp),
+		commands.ConditionsValue(subscription.Status.Conditions),
+		commands.ReadyCondition(subscription.Status.Conditions),
+		commands.NonReadyConditionReason(subscription.Status.Conditions))
+	return []metav1beta1.TableRow{row}, nil
+}
@@ -0,0 +1,106 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subscription
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/knative/client/pkg/eventing"
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func NewSubscriptionCreateCommand(p *commands.KnParams) *cobra.Command {
+	var channel, subscriber, reply string
+
+	subscriptionCreateCommand := &cobra.Command{
+		Use:   "create NAME --channel CHANNEL --subscriber SUBSCRIBER",
+		Short: "Create a subscription.",
+		Example: `
+  # Send the events of the in-memory channel 'mychannel' to the service 'mysvc'
+  kn subscription create mysub --channel mychannel --subscriber svc:mysvc
+
+  # Send the events of the Kafka channel 'mychannel' to the service 'mysvc' and its replies to the broker 'default'
+  kn subscription create mysub --channel KafkaChannel:mychannel --subscriber svc:mysvc --reply broker:default`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("requires the subscription name.")
+			}
+			if channel == "" {
+				return errors.New("requires the channel to subscribe to.")
+			}
+			if subscriber == "" {
+				return errors.New("requires the subscriber to send events to.")
+			}
+			namespace, err := commands.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			channelRef, err := eventing.ParseChannel(channel)
+			if err != nil {
+				return err
+			}
+			subscriberSpec, err := eventing.ParseSink(subscriber)
+			if err != nil {
+				return err
+			}
+			subscription := &eventing.Subscription{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: eventing.SchemeGroupVersion.String(),
+					Kind:       "Subscription",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      args[0],
+					Namespace: namespace,
+				},
+				Spec: eventing.SubscriptionSpec{
+					Channel:    *channelRef,
+					Subscriber: subscriberSpec,
+				},
+			}
+			if reply != "" {
+				subscription.Spec.Reply, err = eventing.ParseSink(reply)
+				if err != nil {
+					return err
+				}
+			}
+			client, err := p.DynamicFactory()
+			if err != nil {
+				return err
+			}
+			obj, err := eventing.ToUnstructured(subscription)
+			if err != nil {
+				return err
+			}
+			_, err = client.Resource(eventing.SubscriptionResource).Namespace(namespace).Create(obj, metav1.CreateOptions{})
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Subscription '%s' successfully created in namespace '%s'.\n", args[0], namespace)
+			return nil
+		},
+	}
+	commands.AddNamespaceFlags(subscriptionCreateCommand.Flags(), false)
+	subscriptionCreateCommand.Flags().StringVar(&channel, "channel", "",
+		"Channel to subscribe to, either 'NAME' for an in-memory channel or 'KIND:NAME'.")
+	subscriptionCreateCommand.Flags().StringVar(&subscriber, "subscriber", "",
+		"Subscriber events are sent to, either 'svc:NAME' for a Knative service, "+
+			"'broker:NAME' for a broker or an http(s) URI.")
+	subscriptionCreateCommand.Flags().StringVar(&reply, "reply", "",
+		"Destination the replies of the subscriber are sent to, in the same format as --subscriber.")
+	return subscriptionCreateCommand
+}
@@ -0,0 +1,71 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subscription
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/knative/client/pkg/eventing"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSubscriptionCreate(t *testing.T) {
+	fakeDynamic, output, err := fakeSubscription([]string{"subscription", "create", "foo",
+		"--channel", "mychannel", "--subscriber", "svc:mysvc", "--reply", "svc:replysvc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if output[0] != "Subscription 'foo' successfully created in namespace 'default'." {
+		t.Errorf("unexpected output: %s", output[0])
+	}
+	obj, err := fakeDynamic.Resource(eventing.SubscriptionResource).Namespace("default").Get("foo", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	subscription := &eventing.Subscription{}
+	err = eventing.FromUnstructured(obj, subscription)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if channel := subscription.Spec.Channel; channel.Kind != "InMemoryChannel" || channel.Name != "mychannel" {
+		t.Errorf("unexpected channel %v", channel)
+	}
+	if subscriber := eventing.FormatSink(subscription.Spec.Subscriber); subscriber != "svc:mysvc" {
+		t.Errorf("unexpected subscriber %s", subscriber)
+	}
+	if reply := eventing.FormatSink(subscription.Spec.Reply); reply != "svc:replysvc" {
+		t.Errorf("unexpected reply %s", reply)
+	}
+}
+
+func TestSubscriptionCreateErrors(t *testing.T) {
+	for _, tc := range []struct {
+		args     []string
+		expected string
+	}{
+		{[]string{"subscription", "create", "--channel", "c", "--subscriber", "svc:s"}, "requires the subscription name."},
+		{[]string{"subscription", "create", "foo", "--subscriber", "svc:s"}, "requires the channel to subscribe to."},
+		{[]string{"subscription", "create", "foo", "--channel", "c"}, "requires the subscriber to send events to."},
+		{[]string{"subscription", "create", "foo", "--channel", "c", "--subscriber", "s"}, "invalid sink 's'"},
+		{[]string{"subscription", "create", "foo", "--channel", "c", "--subscriber", "svc:s", "--reply", "r"}, "invalid sink 'r'"},
+		{[]string{"subscription", "create", "foo", "--channel", "Kafka:", "--subscriber", "svc:s"}, "invalid channel 'Kafka:'"},
+	} {
+		_, _, err := fakeSubscription(tc.args)
+		if err == nil || !strings.Contains(err.Error(), tc.expected) {
+			t.Errorf("expected error '%s' for %v, got %v", tc.expected, tc.args, err)
+		}
+	}
+}
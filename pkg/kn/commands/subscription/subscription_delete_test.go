@@ -0,0 +1,38 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subscription
+
+import (
+	"testing"
+
+	"github.com/knative/client/pkg/eventing"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSubscriptionDelete(t *testing.T) {
+	fakeDynamic, output, err := fakeSubscription([]string{"subscription", "delete", "foo"},
+		createMockSubscription("foo", "mychannel", "svc:mysvc", "", corev1.ConditionTrue))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if output[0] != "Subscription 'foo' successfully deleted in namespace 'default'." {
+		t.Errorf("unexpected output: %s", output[0])
+	}
+	_, err = fakeDynamic.Resource(eventing.SubscriptionResource).Namespace("default").Get("foo", metav1.GetOptions{})
+	if err == nil {
+		t.Error("expected subscription to be deleted")
+	}
+}
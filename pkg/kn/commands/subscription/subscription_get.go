@@ -0,0 +1,72 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subscription
+
+import (
+	"fmt"
+
+	"github.com/knative/client/pkg/eventing"
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func NewSubscriptionGetCommand(p *commands.KnParams) *cobra.Command {
+	subscriptionGetFlags := NewSubscriptionGetFlags()
+
+	subscriptionGetCommand := &cobra.Command{
+		Use:   "get",
+		Short: "Get available subscriptions.",
+		Example: `
+  # Get all subscriptions in the default namespace
+  kn subscription get
+
+  # Get all subscriptions in all namespaces as YAML
+  kn subscription get --all-namespaces -o yaml`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			namespace, err := commands.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.DynamicFactory()
+			if err != nil {
+				return err
+			}
+			list, err := client.Resource(eventing.SubscriptionResource).Namespace(namespace).List(metav1.ListOptions{})
+			if err != nil {
+				return err
+			}
+			subscriptions := &eventing.SubscriptionList{}
+			err = eventing.FromUnstructured(list, subscriptions)
+			if err != nil {
+				return err
+			}
+			if len(subscriptions.Items) == 0 {
+				fmt.Fprintf(cmd.OutOrStdout(), "No resources found.\n")
+				return nil
+			}
+			subscriptions.GetObjectKind().SetGroupVersionKind(eventing.SchemeGroupVersion.WithKind("SubscriptionList"))
+
+			printer, err := subscriptionGetFlags.ToPrinter()
+			if err != nil {
+				return err
+			}
+			return printer.PrintObj(subscriptions, cmd.OutOrStdout())
+		},
+	}
+	commands.AddNamespaceFlags(subscriptionGetCommand.Flags(), true)
+	subscriptionGetFlags.AddFlags(subscriptionGetCommand)
+	return subscriptionGetCommand
+}
@@ -0,0 +1,122 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subscription
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/knative/client/pkg/eventing"
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/knative/pkg/apis"
+	duckv1beta1 "github.com/knative/pkg/apis/duck/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	client_testing "k8s.io/client-go/testing"
+)
+
+// fakeSubscription runs a subscription command against a fake dynamic
+// client containing the given subscriptions
+func fakeSubscription(args []string, subscriptions ...*eventing.Subscription) (fakeDynamic *dynamicfake.FakeDynamicClient, output []string, err error) {
+	knParams := &commands.KnParams{}
+	objects := make([]runtime.Object, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		obj, err := eventing.ToUnstructured(subscription)
+		if err != nil {
+			return nil, nil, err
+		}
+		objects = append(objects, obj)
+	}
+	cmd, _, fakeDynamic, buf := commands.CreateTestKnCommandWithDynamic(NewSubscriptionCommand(knParams), knParams, objects...)
+	fakeDynamic.PrependReactor("list", "subscriptions",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			list := &unstructured.UnstructuredList{}
+			for _, obj := range objects {
+				list.Items = append(list.Items, *obj.(*unstructured.Unstructured))
+			}
+			return true, list, nil
+		})
+	cmd.SetArgs(args)
+	err = cmd.Execute()
+	output = strings.Split(buf.String(), "\n")
+	return
+}
+
+func createMockSubscription(name, channel, subscriber, reply string, ready corev1.ConditionStatus) *eventing.Subscription {
+	channelRef, err := eventing.ParseChannel(channel)
+	if err != nil {
+		panic(err)
+	}
+	subscription := &eventing.Subscription{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: eventing.SchemeGroupVersion.String(),
+			Kind:       "Subscription",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+		Spec: eventing.SubscriptionSpec{Channel: *channelRef},
+	}
+	subscription.Spec.Subscriber, err = eventing.ParseSink(subscriber)
+	if err != nil {
+		panic(err)
+	}
+	if reply != "" {
+		subscription.Spec.Reply, err = eventing.ParseSink(reply)
+		if err != nil {
+			panic(err)
+		}
+	}
+	subscription.Status.Status = duckv1beta1.Status{
+		Conditions: duckv1beta1.Conditions{
+			apis.Condition{Type: apis.ConditionReady, Status: ready, Reason: "SubscriberNotFound"},
+		},
+	}
+	return subscription
+}
+
+func testContains(t *testing.T, output string, sub []string, element string) {
+	for _, each := range sub {
+		if !strings.Contains(output, each) {
+			t.Errorf("Missing %s: %s", element, each)
+		}
+	}
+}
+
+func TestSubscriptionGetEmpty(t *testing.T) {
+	_, output, err := fakeSubscription([]string{"subscription", "get"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if output[0] != "No resources found." {
+		t.Errorf("unexpected output: %s", output[0])
+	}
+}
+
+func TestSubscriptionGet(t *testing.T) {
+	_, output, err := fakeSubscription([]string{"subscription", "get"},
+		createMockSubscription("foo", "mychannel", "svc:mysvc", "broker:default", corev1.ConditionTrue),
+		createMockSubscription("bar", "KafkaChannel:other", "http://example.com", "", corev1.ConditionFalse))
+	if err != nil {
+		t.Fatal(err)
+	}
+	testContains(t, output[0], []string{"NAME", "CHANNEL", "SUBSCRIBER", "REPLY", "AGE", "CONDITIONS", "READY", "REASON"}, "column header")
+	testContains(t, output[1], []string{"foo", "InMemoryChannel:mychannel", "svc:mysvc", "broker:default", "1 OK / 1", "True"}, "value")
+	testContains(t, output[2], []string{"bar", "KafkaChannel:other", "http://example.com", "0 OK / 1", "False", "SubscriberNotFound"}, "value")
+}
@@ -21,18 +21,41 @@ import (
 	serving "github.com/knative/serving/pkg/client/clientset/versioned/typed/serving/v1alpha1"
 	"github.com/knative/serving/pkg/client/clientset/versioned/typed/serving/v1alpha1/fake"
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	corefake "k8s.io/client-go/kubernetes/typed/core/v1/fake"
 	client_testing "k8s.io/client-go/testing"
 )
 
 func CreateTestKnCommand(cmd *cobra.Command, knParams *KnParams) (*cobra.Command, *fake.FakeServingV1alpha1, *bytes.Buffer) {
 	buf := new(bytes.Buffer)
-	fakeServing := &fake.FakeServingV1alpha1{&client_testing.Fake{}}
+	fakeServing := &fake.FakeServingV1alpha1{Fake: &client_testing.Fake{}}
 	knParams.Output = buf
 	knParams.ServingFactory = func() (serving.ServingV1alpha1Interface, error) { return fakeServing, nil }
 	knCommand := newKnCommand(cmd, knParams)
 	return knCommand, fakeServing, buf
 }
 
+// CreateTestKnCommandWithCore is like CreateTestKnCommand but additionally
+// wires up a fake client for the Kubernetes core API group
+func CreateTestKnCommandWithCore(cmd *cobra.Command, knParams *KnParams) (*cobra.Command, *fake.FakeServingV1alpha1, *corefake.FakeCoreV1, *bytes.Buffer) {
+	knCommand, fakeServing, buf := CreateTestKnCommand(cmd, knParams)
+	fakeCore := &corefake.FakeCoreV1{Fake: &client_testing.Fake{}}
+	knParams.CoreFactory = func() (corev1.CoreV1Interface, error) { return fakeCore, nil }
+	return knCommand, fakeServing, fakeCore, buf
+}
+
+// CreateTestKnCommandWithDynamic is like CreateTestKnCommand but additionally
+// wires up a fake dynamic client, which is prepopulated with the given objects
+func CreateTestKnCommandWithDynamic(cmd *cobra.Command, knParams *KnParams, objects ...runtime.Object) (*cobra.Command, *fake.FakeServingV1alpha1, *dynamicfake.FakeDynamicClient, *bytes.Buffer) {
+	knCommand, fakeServing, buf := CreateTestKnCommand(cmd, knParams)
+	fakeDynamic := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), objects...)
+	knParams.DynamicFactory = func() (dynamic.Interface, error) { return fakeDynamic, nil }
+	return knCommand, fakeServing, fakeDynamic, buf
+}
+
 func newKnCommand(subCommand *cobra.Command, params *KnParams) *cobra.Command {
 	rootCmd := &cobra.Command{
 		Use:   "kn",
@@ -0,0 +1,90 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/knative/client/pkg/eventing"
+	"github.com/knative/client/pkg/kn/commands"
+	hprinters "github.com/knative/client/pkg/printers"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// TriggerGetHandlers adds print handlers for trigger get command
+func TriggerGetHandlers(h hprinters.PrintHandler) {
+	triggerColumnDefinitions := []metav1beta1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Description: "Name of the trigger."},
+		{Name: "Broker", Type: "string", Description: "Broker the trigger subscribes to."},
+		{Name: "Filter", Type: "string", Description: "Filter on the attributes of events."},
+		{Name: "Sink", Type: "string", Description: "Sink events are sent to."},
+		{Name: "Age", Type: "string", Description: "Age of the trigger."},
+		{Name: "Conditions", Type: "string", Description: "Conditions describing statuses of trigger components."},
+		{Name: "Ready", Type: "string", Description: "Ready condition status of the trigger."},
+		{Name: "Reason", Type: "string", Description: "Reason for non-ready condition of the trigger."},
+	}
+	h.TableHandler(triggerColumnDefinitions, printTrigger)
+	h.TableHandler(triggerColumnDefinitions, printTriggerList)
+}
+
+// Private functions
+
+// printTriggerList populates the trigger list table rows
+func printTriggerList(triggerList *eventing.TriggerList, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
+	rows := make([]metav1beta1.TableRow, 0, len(triggerList.Items))
+	for _, trigger := range triggerList.Items {
+		r, err := printTrigger(&trigger, options)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, r...)
+	}
+	return rows, nil
+}
+
+// printTrigger populates the trigger table rows
+func printTrigger(trigger *eventing.Trigger, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
+	row := metav1beta1.TableRow{
+		Object: runtime.RawExtension{Object: trigger},
+	}
+	row.Cells = append(row.Cells,
+		trigger.Name,
+		trigger.Spec.Broker,
+		filterValue(trigger.Spec.Filter),
+		eventing.FormatSink(trigger.Spec.Subscriber),
+		commands.TranslateTimestampSince(trigger.CreationTimestamp),
+		commands.ConditionsValue(trigger.Status.Conditions),
+		commands.ReadyCondition(trigger.Status.Conditions),
+		commands.NonReadyConditionReason(trigger.Status.Conditions))
+	return []metav1beta1.TableRow{row}, nil
+}
+
+// filterValue formats the filter like the --filter flags, omitting
+// attributes matching all events
+func filterValue(filter *eventing.TriggerFilter) string {
+	if filter == nil || filter.SourceAndType == nil {
+		return ""
+	}
+	var filters []string
+	if value := filter.SourceAndType.Type; value != "" && value != eventing.TriggerAnyFilter {
+		filters = append(filters, fmt.Sprintf("type=%s", value))
+	}
+	if value := filter.SourceAndType.Source; value != "" && value != eventing.TriggerAnyFilter {
+		filters = append(filters, fmt.Sprintf("source=%s", value))
+	}
+	return strings.Join(filters, ",")
+}
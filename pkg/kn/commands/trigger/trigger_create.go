@@ -0,0 +1,93 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/knative/client/pkg/eventing"
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func NewTriggerCreateCommand(p *commands.KnParams) *cobra.Command {
+	var editFlags TriggerEditFlags
+
+	triggerCreateCommand := &cobra.Command{
+		Use:   "create NAME --sink SINK",
+		Short: "Create a trigger.",
+		Example: `
+  # Send all events of the broker 'default' to the service 'mysvc'
+  kn trigger create mytrigger --sink svc:mysvc
+
+  # Send events of type 'dev.knative.foo' of the broker 'mybroker' to the service 'mysvc'
+  kn trigger create mytrigger --broker mybroker --filter type=dev.knative.foo --sink svc:mysvc`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("requires the trigger name.")
+			}
+			if editFlags.Sink == "" {
+				return errors.New("requires the sink to send events to.")
+			}
+			namespace, err := commands.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			filter, err := editFlags.filter(nil)
+			if err != nil {
+				return err
+			}
+			subscriber, err := editFlags.SinkFlags.Parse()
+			if err != nil {
+				return err
+			}
+			client, err := p.DynamicFactory()
+			if err != nil {
+				return err
+			}
+
+			trigger := &eventing.Trigger{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: eventing.SchemeGroupVersion.String(),
+					Kind:       "Trigger",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      args[0],
+					Namespace: namespace,
+				},
+				Spec: eventing.TriggerSpec{
+					Broker:     editFlags.Broker,
+					Filter:     filter,
+					Subscriber: subscriber,
+				},
+			}
+			obj, err := eventing.ToUnstructured(trigger)
+			if err != nil {
+				return err
+			}
+			_, err = client.Resource(eventing.TriggerResource).Namespace(namespace).Create(obj, metav1.CreateOptions{})
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Trigger '%s' successfully created in namespace '%s'.\n", args[0], namespace)
+			return nil
+		},
+	}
+	commands.AddNamespaceFlags(triggerCreateCommand.Flags(), false)
+	editFlags.AddCreateFlags(triggerCreateCommand)
+	return triggerCreateCommand
+}
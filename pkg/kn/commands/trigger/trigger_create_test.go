@@ -0,0 +1,96 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/knative/client/pkg/eventing"
+	"github.com/knative/client/pkg/kn/commands"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	client_testing "k8s.io/client-go/testing"
+)
+
+func fakeTriggerCreate(args []string) (created *eventing.Trigger, output string, err error) {
+	knParams := &commands.KnParams{}
+	cmd, _, fakeDynamic, buf := commands.CreateTestKnCommandWithDynamic(NewTriggerCommand(knParams), knParams)
+	fakeDynamic.PrependReactor("create", "triggers",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			obj := a.(client_testing.CreateAction).GetObject().(*unstructured.Unstructured)
+			created = &eventing.Trigger{}
+			return false, nil, eventing.FromUnstructured(obj, created)
+		})
+	cmd.SetArgs(args)
+	err = cmd.Execute()
+	output = buf.String()
+	return
+}
+
+func TestTriggerCreate(t *testing.T) {
+	created, output, err := fakeTriggerCreate([]string{"trigger", "create", "foo", "--sink", "svc:mysvc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(output, "Trigger 'foo' successfully created in namespace 'default'.") {
+		t.Errorf("unexpected output: %s", output)
+	}
+	if created.Spec.Broker != "default" {
+		t.Errorf("unexpected broker %s", created.Spec.Broker)
+	}
+	if filter := created.Spec.Filter.SourceAndType; filter.Type != eventing.TriggerAnyFilter || filter.Source != eventing.TriggerAnyFilter {
+		t.Errorf("unexpected filter %v", filter)
+	}
+	ref := created.Spec.Subscriber.Ref
+	if ref == nil || ref.Kind != "Service" || ref.Name != "mysvc" {
+		t.Errorf("unexpected subscriber %v", created.Spec.Subscriber)
+	}
+}
+
+func TestTriggerCreateWithFilter(t *testing.T) {
+	created, _, err := fakeTriggerCreate([]string{"trigger", "create", "foo", "--broker", "mybroker",
+		"--filter", "type=dev.knative.foo", "--filter", "source=/apis/v1/namespaces/default", "--sink", "http://example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.Spec.Broker != "mybroker" {
+		t.Errorf("unexpected broker %s", created.Spec.Broker)
+	}
+	if filter := created.Spec.Filter.SourceAndType; filter.Type != "dev.knative.foo" || filter.Source != "/apis/v1/namespaces/default" {
+		t.Errorf("unexpected filter %v", filter)
+	}
+	if uri := created.Spec.Subscriber.URI; uri == nil || *uri != "http://example.com" {
+		t.Errorf("unexpected subscriber %v", created.Spec.Subscriber)
+	}
+}
+
+func TestTriggerCreateErrors(t *testing.T) {
+	for _, tc := range []struct {
+		args     []string
+		expected string
+	}{
+		{[]string{"trigger", "create", "--sink", "svc:mysvc"}, "requires the trigger name."},
+		{[]string{"trigger", "create", "foo"}, "requires the sink to send events to."},
+		{[]string{"trigger", "create", "foo", "--sink", "mysvc"}, "invalid sink 'mysvc'"},
+		{[]string{"trigger", "create", "foo", "--sink", "svc:mysvc", "--filter", "subject=bar"}, "invalid filter 'subject=bar'"},
+		{[]string{"trigger", "create", "foo", "--sink", "svc:mysvc", "--filter", "type"}, "invalid filter 'type'"},
+	} {
+		_, _, err := fakeTriggerCreate(tc.args)
+		if err == nil || !strings.Contains(err.Error(), tc.expected) {
+			t.Errorf("expected error '%s' for %v, got %v", tc.expected, tc.args, err)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/knative/client/pkg/eventing"
+	"github.com/knative/client/pkg/kn/commands"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestTriggerDelete(t *testing.T) {
+	knParams := &commands.KnParams{}
+	trigger, err := eventing.ToUnstructured(createMockTrigger("foo", "default", "dev.knative.foo", "svc:foo"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd, _, fakeDynamic, buf := commands.CreateTestKnCommandWithDynamic(NewTriggerCommand(knParams), knParams, trigger)
+	cmd.SetArgs([]string{"trigger", "delete", "foo"})
+	err = cmd.Execute()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), "Trigger 'foo' successfully deleted in namespace 'default'.") {
+		t.Errorf("unexpected output: %s", buf.String())
+	}
+	_, err = fakeDynamic.Resource(eventing.TriggerResource).Namespace("default").Get("foo", metav1.GetOptions{})
+	if err == nil {
+		t.Error("expected trigger to be deleted")
+	}
+}
+
+func TestTriggerDeleteNoName(t *testing.T) {
+	knParams := &commands.KnParams{}
+	cmd, _, _, _ := commands.CreateTestKnCommandWithDynamic(NewTriggerCommand(knParams), knParams)
+	cmd.SetArgs([]string{"trigger", "delete"})
+	err := cmd.Execute()
+	if err == nil || err.Error() != "requires the trigger name." {
+		t.Errorf("expected error for missing name, got %v", err)
+	}
+}
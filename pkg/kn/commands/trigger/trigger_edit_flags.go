@@ -0,0 +1,73 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/knative/client/pkg/eventing"
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/spf13/cobra"
+)
+
+type TriggerEditFlags struct {
+	Broker  string
+	Filters []string
+	commands.SinkFlags
+}
+
+func (f *TriggerEditFlags) AddUpdateFlags(command *cobra.Command) {
+	command.Flags().StringArrayVar(&f.Filters, "filter", []string{},
+		"Filter on an event attribute, either 'type=TYPE' or 'source=SOURCE'. "+
+			"Attributes without a filter match all events; on update, they keep their current filter. "+
+			"Use 'type=Any' or 'source=Any' to match all values again. "+
+			"To filter on both attributes, specify the flag twice.")
+	f.SinkFlags.Add(command)
+}
+
+func (f *TriggerEditFlags) AddCreateFlags(command *cobra.Command) {
+	command.Flags().StringVar(&f.Broker, "broker", "default", "Broker the trigger subscribes to.")
+	f.AddUpdateFlags(command)
+}
+
+// Build the filter of the trigger from the --filter flags, starting from
+// the given current filter. Attributes without a flag keep their current
+// filter, or match all events if there is none.
+func (f *TriggerEditFlags) filter(current *eventing.TriggerFilter) (*eventing.TriggerFilter, error) {
+	sourceAndType := &eventing.TriggerFilterSourceAndType{
+		Type:   eventing.TriggerAnyFilter,
+		Source: eventing.TriggerAnyFilter,
+	}
+	if current != nil && current.SourceAndType != nil {
+		copied := *current.SourceAndType
+		sourceAndType = &copied
+	}
+	for _, filter := range f.Filters {
+		parts := strings.SplitN(filter, "=", 2)
+		if len(parts) != 2 || parts[1] == "" {
+			return nil, fmt.Errorf("invalid filter '%s', expected 'type=TYPE' or 'source=SOURCE'", filter)
+		}
+		switch parts[0] {
+		case "type":
+			sourceAndType.Type = parts[1]
+		case "source":
+			sourceAndType.Source = parts[1]
+		default:
+			return nil, fmt.Errorf("invalid filter '%s', only 'type' and 'source' are supported", filter)
+		}
+	}
+	return &eventing.TriggerFilter{SourceAndType: sourceAndType}, nil
+}
@@ -0,0 +1,133 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/knative/client/pkg/eventing"
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/knative/pkg/apis"
+	duckv1beta1 "github.com/knative/pkg/apis/duck/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	client_testing "k8s.io/client-go/testing"
+)
+
+func fakeTriggerGet(args []string, triggers ...*eventing.Trigger) (action client_testing.Action, output []string, err error) {
+	knParams := &commands.KnParams{}
+	cmd, _, fakeDynamic, buf := commands.CreateTestKnCommandWithDynamic(NewTriggerCommand(knParams), knParams)
+	fakeDynamic.PrependReactor("list", "triggers",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			action = a
+			list := &unstructured.UnstructuredList{}
+			for _, trigger := range triggers {
+				obj, err := eventing.ToUnstructured(trigger)
+				if err != nil {
+					return true, nil, err
+				}
+				list.Items = append(list.Items, *obj)
+			}
+			return true, list, nil
+		})
+	cmd.SetArgs(args)
+	err = cmd.Execute()
+	output = strings.Split(buf.String(), "\n")
+	return
+}
+
+func TestTriggerGetEmpty(t *testing.T) {
+	action, output, err := fakeTriggerGet([]string{"trigger", "get"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if action == nil || !action.Matches("list", "triggers") {
+		t.Errorf("Bad action %v", action)
+	} else if output[0] != "No resources found." {
+		t.Errorf("Bad output %s", output[0])
+	}
+}
+
+func TestTriggerGetDefaultOutput(t *testing.T) {
+	trigger1 := createMockTrigger("foo", "default", "dev.knative.foo", "svc:foo")
+	trigger2 := createMockTrigger("bar", "mybroker", eventing.TriggerAnyFilter, "http://example.com")
+	trigger2.Status.Conditions[0] = apis.Condition{Type: apis.ConditionReady, Status: corev1.ConditionFalse, Reason: "BrokerDoesNotExist"}
+	_, output, err := fakeTriggerGet([]string{"trigger", "get"}, trigger1, trigger2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testContains(t, output[0], []string{"NAME", "BROKER", "FILTER", "SINK", "AGE", "CONDITIONS", "READY", "REASON"}, "column header")
+	testContains(t, output[1], []string{"foo", "default", "type=dev.knative.foo", "svc:foo", "1 OK / 1", "True"}, "value")
+	testContains(t, output[2], []string{"bar", "mybroker", "http://example.com", "0 OK / 1", "False", "BrokerDoesNotExist"}, "value")
+	if strings.Contains(output[2], "type=") {
+		t.Errorf("unexpected filter in %s", output[2])
+	}
+}
+
+func TestTriggerGetAllNamespaces(t *testing.T) {
+	action, _, err := fakeTriggerGet([]string{"trigger", "get", "--all-namespaces"},
+		createMockTrigger("foo", "default", "dev.knative.foo", "svc:foo"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if action.GetNamespace() != "" {
+		t.Errorf("Expected listing in all namespaces, got %s", action.GetNamespace())
+	}
+}
+
+func testContains(t *testing.T, output string, sub []string, element string) {
+	for _, each := range sub {
+		if !strings.Contains(output, each) {
+			t.Errorf("Missing %s: %s", element, each)
+		}
+	}
+}
+
+func createMockTrigger(name, broker, eventType, sink string) *eventing.Trigger {
+	subscriber, err := eventing.ParseSink(sink)
+	if err != nil {
+		panic(err)
+	}
+	return &eventing.Trigger{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: eventing.SchemeGroupVersion.String(),
+			Kind:       "Trigger",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+		Spec: eventing.TriggerSpec{
+			Broker: broker,
+			Filter: &eventing.TriggerFilter{
+				SourceAndType: &eventing.TriggerFilterSourceAndType{
+					Type:   eventType,
+					Source: eventing.TriggerAnyFilter,
+				},
+			},
+			Subscriber: subscriber,
+		},
+		Status: eventing.TriggerStatus{
+			Status: duckv1beta1.Status{
+				Conditions: duckv1beta1.Conditions{
+					apis.Condition{Type: apis.ConditionReady, Status: corev1.ConditionTrue},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/knative/client/pkg/eventing"
+	"github.com/knative/client/pkg/kn/commands"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func NewTriggerUpdateCommand(p *commands.KnParams) *cobra.Command {
+	var editFlags TriggerEditFlags
+
+	triggerUpdateCommand := &cobra.Command{
+		Use:   "update NAME",
+		Short: "Update a trigger.",
+		Example: `
+  # Only send events of type 'dev.knative.foo' to the subscriber of trigger 'mytrigger'
+  kn trigger update mytrigger --filter type=dev.knative.foo
+
+  # Send the events of trigger 'mytrigger' to the service 'othersvc'
+  kn trigger update mytrigger --sink svc:othersvc`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("requires the trigger name.")
+			}
+			if !cmd.Flags().Changed("filter") && !cmd.Flags().Changed("sink") {
+				return errors.New("requires a filter or a sink to update.")
+			}
+			namespace, err := commands.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.DynamicFactory()
+			if err != nil {
+				return err
+			}
+			triggers := client.Resource(eventing.TriggerResource).Namespace(namespace)
+			obj, err := triggers.Get(args[0], metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+
+			// The fetched object is updated in place so that fields unknown
+			// to kn are kept
+			if cmd.Flags().Changed("filter") {
+				trigger := &eventing.Trigger{}
+				err = eventing.FromUnstructured(obj, trigger)
+				if err != nil {
+					return err
+				}
+				filter, err := editFlags.filter(trigger.Spec.Filter)
+				if err != nil {
+					return err
+				}
+				err = eventing.SetNestedField(obj, filter, "spec", "filter")
+				if err != nil {
+					return err
+				}
+			}
+			if cmd.Flags().Changed("sink") {
+				subscriber, err := editFlags.SinkFlags.Parse()
+				if err != nil {
+					return err
+				}
+				err = eventing.SetNestedField(obj, subscriber, "spec", "subscriber")
+				if err != nil {
+					return err
+				}
+			}
+			_, err = triggers.Update(obj, metav1.UpdateOptions{})
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Trigger '%s' successfully updated in namespace '%s'.\n", args[0], namespace)
+			return nil
+		},
+	}
+	commands.AddNamespaceFlags(triggerUpdateCommand.Flags(), false)
+	editFlags.AddUpdateFlags(triggerUpdateCommand)
+	return triggerUpdateCommand
+}
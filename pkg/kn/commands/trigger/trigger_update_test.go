@@ -0,0 +1,113 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/knative/client/pkg/eventing"
+	"github.com/knative/client/pkg/kn/commands"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func fakeTriggerUpdate(args []string, trigger *eventing.Trigger) (obj *unstructured.Unstructured, output string, err error) {
+	knParams := &commands.KnParams{}
+	existing, err := eventing.ToUnstructured(trigger)
+	if err != nil {
+		return
+	}
+	unstructured.SetNestedField(existing.Object, "keep", "spec", "unknown")
+	cmd, _, fakeDynamic, buf := commands.CreateTestKnCommandWithDynamic(NewTriggerCommand(knParams), knParams, existing)
+	cmd.SetArgs(args)
+	err = cmd.Execute()
+	output = buf.String()
+	if err != nil {
+		return
+	}
+	obj, err = fakeDynamic.Resource(eventing.TriggerResource).Namespace("default").Get(trigger.Name, metav1.GetOptions{})
+	return
+}
+
+func TestTriggerUpdateFilter(t *testing.T) {
+	obj, output, err := fakeTriggerUpdate([]string{"trigger", "update", "foo", "--filter", "source=bar"},
+		createMockTrigger("foo", "default", "dev.knative.foo", "svc:foo"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(output, "Trigger 'foo' successfully updated in namespace 'default'.") {
+		t.Errorf("unexpected output: %s", output)
+	}
+	updated := &eventing.Trigger{}
+	err = eventing.FromUnstructured(obj, updated)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the filter on the type isn't touched
+	if filter := updated.Spec.Filter.SourceAndType; filter.Type != "dev.knative.foo" || filter.Source != "bar" {
+		t.Errorf("unexpected filter %v", filter)
+	}
+	if sink := eventing.FormatSink(updated.Spec.Subscriber); sink != "svc:foo" {
+		t.Errorf("unexpected sink %s", sink)
+	}
+	if unknown, _, _ := unstructured.NestedString(obj.Object, "spec", "unknown"); unknown != "keep" {
+		t.Error("unknown field not preserved")
+	}
+}
+
+func TestTriggerUpdateFilterAny(t *testing.T) {
+	trigger := createMockTrigger("foo", "default", "dev.knative.foo", "svc:foo")
+	trigger.Spec.Filter.SourceAndType.Source = "bar"
+	obj, _, err := fakeTriggerUpdate([]string{"trigger", "update", "foo", "--filter", "type=Any"}, trigger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	updated := &eventing.Trigger{}
+	err = eventing.FromUnstructured(obj, updated)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if filter := updated.Spec.Filter.SourceAndType; filter.Type != eventing.TriggerAnyFilter || filter.Source != "bar" {
+		t.Errorf("unexpected filter %v", filter)
+	}
+}
+
+func TestTriggerUpdateSink(t *testing.T) {
+	obj, _, err := fakeTriggerUpdate([]string{"trigger", "update", "foo", "--sink", "broker:other"},
+		createMockTrigger("foo", "default", "dev.knative.foo", "svc:foo"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	updated := &eventing.Trigger{}
+	err = eventing.FromUnstructured(obj, updated)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if filter := updated.Spec.Filter.SourceAndType; filter.Type != "dev.knative.foo" {
+		t.Errorf("unexpected filter %v", filter)
+	}
+	if sink := eventing.FormatSink(updated.Spec.Subscriber); sink != "broker:other" {
+		t.Errorf("unexpected sink %s", sink)
+	}
+}
+
+func TestTriggerUpdateNothing(t *testing.T) {
+	_, _, err := fakeTriggerUpdate([]string{"trigger", "update", "foo"},
+		createMockTrigger("foo", "default", "dev.knative.foo", "svc:foo"))
+	if err == nil || err.Error() != "requires a filter or a sink to update." {
+		t.Errorf("expected error for missing update, got %v", err)
+	}
+}
@@ -18,6 +18,9 @@ import (
 	"io"
 
 	serving "github.com/knative/serving/pkg/client/clientset/versioned/typed/serving/v1alpha1"
+	"k8s.io/client-go/dynamic"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 )
 
@@ -28,16 +31,31 @@ var KubeCfgFile string
 type KnParams struct {
 	Output         io.Writer
 	ServingFactory func() (serving.ServingV1alpha1Interface, error)
+	CoreFactory    func() (corev1.CoreV1Interface, error)
+	DynamicFactory func() (dynamic.Interface, error)
+
+	// ContextServingFactory creates a serving client for the given context
+	// of the kubeconfig
+	ContextServingFactory func(context string) (serving.ServingV1alpha1Interface, error)
 }
 
 func (c *KnParams) Initialize() {
 	if c.ServingFactory == nil {
 		c.ServingFactory = GetConfig
 	}
+	if c.CoreFactory == nil {
+		c.CoreFactory = GetCoreClient
+	}
+	if c.DynamicFactory == nil {
+		c.DynamicFactory = GetDynamicClient
+	}
+	if c.ContextServingFactory == nil {
+		c.ContextServingFactory = GetConfigForContext
+	}
 }
 
 func GetConfig() (serving.ServingV1alpha1Interface, error) {
-	config, err := clientcmd.BuildConfigFromFlags("", KubeCfgFile)
+	config, err := getRestConfig()
 	if err != nil {
 		return nil, err
 	}
@@ -47,3 +65,51 @@ func GetConfig() (serving.ServingV1alpha1Interface, error) {
 	}
 	return client, nil
 }
+
+// GetConfigForContext returns a serving client for the given kubeconfig
+// context instead of the current one
+func GetConfigForContext(context string) (serving.ServingV1alpha1Interface, error) {
+	loadingRules := &clientcmd.ClientConfigLoadingRules{ExplicitPath: KubeCfgFile}
+	overrides := &clientcmd.ConfigOverrides{CurrentContext: context}
+	config, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, overrides).ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	client, err := serving.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+// GetCoreClient returns a client for the Kubernetes core API group, used for
+// looking up objects like the ingress gateway service or events
+func GetCoreClient() (corev1.CoreV1Interface, error) {
+	config, err := getRestConfig()
+	if err != nil {
+		return nil, err
+	}
+	client, err := corev1.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+// GetDynamicClient returns a client for resources without a generated
+// clientset, like builds or eventing objects
+func GetDynamicClient() (dynamic.Interface, error) {
+	config, err := getRestConfig()
+	if err != nil {
+		return nil, err
+	}
+	client, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+func getRestConfig() (*rest.Config, error) {
+	return clientcmd.BuildConfigFromFlags("", KubeCfgFile)
+}
@@ -21,8 +21,19 @@ import (
 	"path/filepath"
 
 	"github.com/knative/client/pkg/kn/commands"
+	"github.com/knative/client/pkg/kn/commands/admin"
+	"github.com/knative/client/pkg/kn/commands/broker"
+	"github.com/knative/client/pkg/kn/commands/build"
+	"github.com/knative/client/pkg/kn/commands/channel"
+	"github.com/knative/client/pkg/kn/commands/configuration"
+	"github.com/knative/client/pkg/kn/commands/domain"
+	"github.com/knative/client/pkg/kn/commands/event"
 	"github.com/knative/client/pkg/kn/commands/revision"
+	"github.com/knative/client/pkg/kn/commands/route"
 	"github.com/knative/client/pkg/kn/commands/service"
+	"github.com/knative/client/pkg/kn/commands/source"
+	"github.com/knative/client/pkg/kn/commands/subscription"
+	"github.com/knative/client/pkg/kn/commands/trigger"
 	homedir "github.com/mitchellh/go-homedir"
 	"github.com/spf13/cobra"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
@@ -66,6 +77,17 @@ Eventing: Manage event subscriptions and channels. Connect up event sources.`,
 
 	rootCmd.AddCommand(service.NewServiceCommand(p))
 	rootCmd.AddCommand(revision.NewRevisionCommand(p))
+	rootCmd.AddCommand(route.NewRouteCommand(p))
+	rootCmd.AddCommand(configuration.NewConfigurationCommand(p))
+	rootCmd.AddCommand(domain.NewDomainCommand(p))
+	rootCmd.AddCommand(build.NewBuildCommand(p))
+	rootCmd.AddCommand(broker.NewBrokerCommand(p))
+	rootCmd.AddCommand(trigger.NewTriggerCommand(p))
+	rootCmd.AddCommand(source.NewSourceCommand(p))
+	rootCmd.AddCommand(channel.NewChannelCommand(p))
+	rootCmd.AddCommand(subscription.NewSubscriptionCommand(p))
+	rootCmd.AddCommand(event.NewEventCommand(p))
+	rootCmd.AddCommand(admin.NewAdminCommand(p))
 	rootCmd.AddCommand(commands.NewCompletionCommand(p))
 	rootCmd.AddCommand(commands.NewVersionCommand(p))
 
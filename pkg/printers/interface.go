@@ -38,5 +38,17 @@ func (fn ResourcePrinterFunc) PrintObj(obj runtime.Object, w io.Writer) error {
 
 // PrintOptions for different table printing options
 type PrintOptions struct {
-	//TODO: Add options for eg: with-kind, server-printing, wide etc
+	// Wide includes the columns with a priority greater than 0, which
+	// are hidden by default
+	Wide bool
+	// NoHeaders omits the header line of the table
+	NoHeaders bool
+	// SortBy is a JSONPath expression, by whose value in the objects behind
+	// the rows the table is sorted
+	SortBy string
+	// ShowLabels adds a column with all labels of the objects
+	ShowLabels bool
+	// ColumnLabels are label keys, for each of which a column with the
+	// label's value is added
+	ColumnLabels []string
 }
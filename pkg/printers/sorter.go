@@ -0,0 +1,125 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printers
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// ParseSortBy parses a JSONPath expression for sorting table rows. Braces
+// and the leading dot may be omitted, e.g. "metadata.name" is the same
+// as "{.metadata.name}".
+func ParseSortBy(field string) (*jsonpath.JSONPath, error) {
+	expression := strings.TrimSpace(field)
+	if !strings.HasPrefix(expression, "{") {
+		if !strings.HasPrefix(expression, ".") {
+			expression = "." + expression
+		}
+		expression = "{" + expression + "}"
+	}
+	parser := jsonpath.New("sort-by").AllowMissingKeys(true)
+	if err := parser.Parse(expression); err != nil {
+		return nil, fmt.Errorf("invalid sort field '%s': %v", field, err)
+	}
+	return parser, nil
+}
+
+// sortRows sorts the rows by the value the given JSONPath expression
+// selects in the objects behind the rows. Rows without a value come first.
+func sortRows(rows []metav1beta1.TableRow, field string) error {
+	parser, err := ParseSortBy(field)
+	if err != nil {
+		return err
+	}
+	values := make([]interface{}, len(rows))
+	for i, row := range rows {
+		values[i], err = sortValue(parser, row.Object.Object)
+		if err != nil {
+			return err
+		}
+	}
+	indices := make([]int, len(rows))
+	for i := range indices {
+		indices[i] = i
+	}
+	sort.SliceStable(indices, func(i, j int) bool {
+		return lessValue(values[indices[i]], values[indices[j]])
+	})
+	sorted := make([]metav1beta1.TableRow, len(rows))
+	for i, index := range indices {
+		sorted[i] = rows[index]
+	}
+	copy(rows, sorted)
+	return nil
+}
+
+// =======================================================================================
+
+func sortValue(parser *jsonpath.JSONPath, obj runtime.Object) (interface{}, error) {
+	if obj == nil {
+		return nil, nil
+	}
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	results, err := parser.FindResults(content)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 || len(results[0]) == 0 {
+		return nil, nil
+	}
+	value := results[0][0]
+	for value.Kind() == reflect.Interface || value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return nil, nil
+		}
+		value = value.Elem()
+	}
+	return value.Interface(), nil
+}
+
+func lessValue(a, b interface{}) bool {
+	if a == nil || b == nil {
+		return a == nil && b != nil
+	}
+	switch a := a.(type) {
+	case int64:
+		if b, ok := b.(int64); ok {
+			return a < b
+		}
+	case float64:
+		if b, ok := b.(float64); ok {
+			return a < b
+		}
+	case bool:
+		if b, ok := b.(bool); ok {
+			return !a && b
+		}
+	case string:
+		if b, ok := b.(string); ok {
+			return a < b
+		}
+	}
+	return fmt.Sprint(a) < fmt.Sprint(b)
+}
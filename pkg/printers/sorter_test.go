@@ -0,0 +1,70 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printers
+
+import (
+	"fmt"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func newRow(name string, replicas int32) metav1beta1.TableRow {
+	rc := &corev1.ReplicationController{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	if replicas > 0 {
+		rc.Spec.Replicas = &replicas
+	}
+	return metav1beta1.TableRow{Cells: []interface{}{name}, Object: runtime.RawExtension{Object: rc}}
+}
+
+func rowNames(rows []metav1beta1.TableRow) []string {
+	names := make([]string, 0, len(rows))
+	for _, row := range rows {
+		names = append(names, row.Cells[0].(string))
+	}
+	return names
+}
+
+func TestSortRows(t *testing.T) {
+	testCases := []struct {
+		field    string
+		expected string
+	}{
+		{"{.metadata.name}", "[a b c d]"},
+		{".metadata.name", "[a b c d]"},
+		{"metadata.name", "[a b c d]"},
+		{"spec.replicas", "[d c a b]"},
+	}
+	for _, tc := range testCases {
+		rows := []metav1beta1.TableRow{newRow("c", 10), newRow("a", 20), newRow("d", 0), newRow("b", 30)}
+		err := sortRows(rows, tc.field)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if names := rowNames(rows); fmt.Sprint(names) != tc.expected {
+			t.Errorf("wrong order for %s: %v, expected %s", tc.field, names, tc.expected)
+		}
+	}
+}
+
+func TestParseSortByInvalid(t *testing.T) {
+	_, err := ParseSortBy("{.metadata.name")
+	if err == nil {
+		t.Fatal("expected error for invalid JSONPath")
+	}
+}
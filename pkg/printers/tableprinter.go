@@ -20,8 +20,10 @@ import (
 	"fmt"
 	"io"
 	"reflect"
+	"sort"
 	"strings"
 
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"text/tabwriter"
@@ -74,14 +76,27 @@ func printRowsForHandlerEntry(output io.Writer, handler *handlerEntry, obj runti
 	}
 
 	var headers []string
-	for _, column := range handler.columnDefinitions {
+	var columns []int
+	for i, column := range handler.columnDefinitions {
+		if column.Priority != 0 && !options.Wide {
+			continue
+		}
 		headers = append(headers, strings.ToUpper(column.Name))
+		columns = append(columns, i)
+	}
+	headers = append(headers, labelHeaders(options)...)
+	if !options.NoHeaders {
+		printHeader(headers, output)
 	}
-	printHeader(headers, output)
 
 	if results[1].IsNil() {
 		rows := results[0].Interface().([]metav1beta1.TableRow)
-		printRows(output, rows, options)
+		if options.SortBy != "" {
+			if err := sortRows(rows, options.SortBy); err != nil {
+				return err
+			}
+		}
+		printRows(output, rows, columns, options)
 		return nil
 	}
 	return results[1].Interface().(error)
@@ -94,15 +109,66 @@ func printHeader(columnNames []string, w io.Writer) error {
 	return nil
 }
 
-// printRows writes the provided rows to output.
-func printRows(output io.Writer, rows []metav1beta1.TableRow, options PrintOptions) {
+// printRows writes the cells of the provided rows to output, which
+// belong to the given column indices, followed by the label columns.
+func printRows(output io.Writer, rows []metav1beta1.TableRow, columns []int, options PrintOptions) {
 	for _, row := range rows {
-		for i, cell := range row.Cells {
+		for i, column := range columns {
 			if i != 0 {
 				fmt.Fprint(output, "\t")
 			}
-			fmt.Fprint(output, cell)
+			if column < len(row.Cells) {
+				fmt.Fprint(output, row.Cells[column])
+			}
+		}
+		for _, cell := range labelCells(row, options) {
+			fmt.Fprint(output, "\t", cell)
 		}
 		output.Write([]byte("\n"))
 	}
 }
+
+// labelHeaders returns the headers of the label columns. Like kubectl, only
+// the name part of a label key with a prefix is used.
+func labelHeaders(options PrintOptions) []string {
+	var headers []string
+	for _, key := range options.ColumnLabels {
+		parts := strings.Split(key, "/")
+		headers = append(headers, strings.ToUpper(parts[len(parts)-1]))
+	}
+	if options.ShowLabels {
+		headers = append(headers, "LABELS")
+	}
+	return headers
+}
+
+// labelCells returns the label column values of the object behind the row
+func labelCells(row metav1beta1.TableRow, options PrintOptions) []string {
+	if len(options.ColumnLabels) == 0 && !options.ShowLabels {
+		return nil
+	}
+	var labels map[string]string
+	if accessor, err := meta.Accessor(row.Object.Object); err == nil {
+		labels = accessor.GetLabels()
+	}
+	var cells []string
+	for _, key := range options.ColumnLabels {
+		cells = append(cells, labels[key])
+	}
+	if options.ShowLabels {
+		cells = append(cells, formatLabels(labels))
+	}
+	return cells
+}
+
+func formatLabels(labels map[string]string) string {
+	if len(labels) == 0 {
+		return "<none>"
+	}
+	pairs := make([]string, 0, len(labels))
+	for key, value := range labels {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
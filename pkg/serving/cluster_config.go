@@ -0,0 +1,191 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/knative/serving/pkg/deployment"
+	"github.com/knative/serving/pkg/gc"
+	"github.com/knative/serving/pkg/network"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// A section of the serving configuration, stored in a ConfigMap of the
+// serving namespace
+type ConfigSection struct {
+	Name      string
+	ConfigMap string
+	// Keys understood by serving, in the order they are documented
+	Keys  []string
+	parse func(data map[string]string) error
+}
+
+// Key in the ConfigMaps of serving holding documentation instead of a
+// setting
+const ConfigExampleKey = "_example"
+
+// The sections of the serving configuration which can be edited
+var ConfigSections = []*ConfigSection{
+	{
+		Name:      "autoscaler",
+		ConfigMap: "config-autoscaler",
+		Keys: []string{
+			"enable-scale-to-zero",
+			"container-concurrency-target-percentage",
+			"container-concurrency-target-default",
+			"max-scale-up-rate",
+			"stable-window",
+			"panic-window-percentage",
+			"panic-threshold-percentage",
+			"panic-window",
+			"tick-interval",
+			"scale-to-zero-grace-period",
+		},
+		parse: parseAutoscalerConfig,
+	},
+	{
+		Name:      "network",
+		ConfigMap: network.ConfigName,
+		Keys: []string{
+			network.IstioOutboundIPRangesKey,
+			network.DefaultClusterIngressClassKey,
+			network.DomainTemplateKey,
+			network.AutoTLSKey,
+			network.HTTPProtocolKey,
+		},
+		parse: func(data map[string]string) error {
+			_, err := network.NewConfigFromConfigMap(&corev1.ConfigMap{Data: data})
+			return err
+		},
+	},
+	{
+		Name:      "gc",
+		ConfigMap: gc.ConfigName,
+		Keys: []string{
+			"stale-revision-create-delay",
+			"stale-revision-timeout",
+			"stale-revision-minimum-generations",
+			"stale-revision-lastpinned-debounce",
+		},
+		parse: func(data map[string]string) error {
+			_, err := gc.NewConfigFromConfigMap(&corev1.ConfigMap{Data: data})
+			return err
+		},
+	},
+	{
+		Name:      "deployment",
+		ConfigMap: deployment.ConfigName,
+		Keys: []string{
+			deployment.QueueSidecarImageKey,
+			"registriesSkippingTagResolving",
+		},
+		parse: func(data map[string]string) error {
+			_, err := deployment.NewConfigFromMap(data)
+			return err
+		},
+	},
+}
+
+// Look up a configuration section by its name or the name of its ConfigMap
+func GetConfigSection(name string) (*ConfigSection, error) {
+	names := make([]string, 0, len(ConfigSections))
+	for _, section := range ConfigSections {
+		if name == section.Name || name == section.ConfigMap {
+			return section, nil
+		}
+		names = append(names, section.Name)
+	}
+	return nil, fmt.Errorf("unknown configuration section '%s', expected one of %s", name, strings.Join(names, ", "))
+}
+
+// Check that the given keys are understood by serving. Keys already in the
+// current data of the ConfigMap aren't checked, as they may be understood by
+// a newer serving, and so that outdated keys can still be removed.
+func (s *ConfigSection) CheckKeys(keys []string, current map[string]string) error {
+	known := map[string]bool{}
+	for _, key := range s.Keys {
+		known[key] = true
+	}
+	var unknown []string
+	for _, key := range keys {
+		if _, exists := current[key]; !known[key] && !exists {
+			unknown = append(unknown, key)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return fmt.Errorf("unknown keys %s in section '%s', expected %s",
+			strings.Join(unknown, ", "), s.Name, strings.Join(s.Keys, ", "))
+	}
+	return nil
+}
+
+// Check that the configuration has valid values, as parsed by serving
+func (s *ConfigSection) Validate(data map[string]string) error {
+	err := s.parse(data)
+	if err != nil {
+		return fmt.Errorf("invalid configuration of section '%s': %v", s.Name, err)
+	}
+	return nil
+}
+
+// =======================================================================================
+
+// parseAutoscalerConfig follows NewConfigFromMap of serving's autoscaler
+// package, which isn't vendored as it comes with the dependencies of the
+// autoscaler itself. Only the types and the minimum grace period for
+// scaling to zero are checked, which the help of 'kn admin config set'
+// points out. Booleans are parsed strictly instead of treating anything but
+// "true" as false.
+func parseAutoscalerConfig(data map[string]string) error {
+	for _, key := range []string{"enable-scale-to-zero"} {
+		if raw, ok := data[key]; ok {
+			if _, err := strconv.ParseBool(raw); err != nil {
+				return fmt.Errorf("%s: %v", key, err)
+			}
+		}
+	}
+	for _, key := range []string{
+		"max-scale-up-rate",
+		"container-concurrency-target-percentage",
+		"container-concurrency-target-default",
+		"panic-window-percentage",
+		"panic-threshold-percentage",
+	} {
+		if raw, ok := data[key]; ok {
+			if _, err := strconv.ParseFloat(raw, 64); err != nil {
+				return fmt.Errorf("%s: %v", key, err)
+			}
+		}
+	}
+	for _, key := range []string{"stable-window", "panic-window", "scale-to-zero-grace-period", "tick-interval"} {
+		if raw, ok := data[key]; ok {
+			if _, err := time.ParseDuration(raw); err != nil {
+				return fmt.Errorf("%s: %v", key, err)
+			}
+		}
+	}
+	if raw, ok := data["scale-to-zero-grace-period"]; ok {
+		if gracePeriod, _ := time.ParseDuration(raw); gracePeriod < 30*time.Second {
+			return fmt.Errorf("scale-to-zero-grace-period must be at least 30s, got %v", gracePeriod)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGetConfigSection(t *testing.T) {
+	for _, name := range []string{"autoscaler", "config-autoscaler"} {
+		section, err := GetConfigSection(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if section.ConfigMap != "config-autoscaler" {
+			t.Errorf("wrong section %s for %s", section.Name, name)
+		}
+	}
+	_, err := GetConfigSection("defaults")
+	if err == nil || err.Error() != "unknown configuration section 'defaults', expected one of autoscaler, network, gc, deployment" {
+		t.Errorf("expected error for unknown section, got %v", err)
+	}
+}
+
+func TestConfigSectionValidate(t *testing.T) {
+	for _, tc := range []struct {
+		section string
+		data    map[string]string
+		err     string
+	}{
+		{"autoscaler", map[string]string{"stable-window": "60s", "max-scale-up-rate": "10", ConfigExampleKey: "docs"}, ""},
+		{"autoscaler", map[string]string{"stable-window": "60"}, "stable-window"},
+		{"autoscaler", map[string]string{"enable-scale-to-zero": "yes"}, "enable-scale-to-zero"},
+		{"autoscaler", map[string]string{"scale-to-zero-grace-period": "10s"}, "must be at least 30s"},
+		{"network", map[string]string{"httpProtocol": "Redirected"}, ""},
+		{"network", map[string]string{"httpProtocol": "sometimes"}, "httpProtocol sometimes"},
+		{"network", map[string]string{"domainTemplate": "{{.Name"}, "invalid configuration of section 'network'"},
+		{"gc", map[string]string{"stale-revision-minimum-generations": "2"}, ""},
+		{"gc", map[string]string{"stale-revision-minimum-generations": "two"}, "invalid syntax"},
+		{"deployment", map[string]string{"queueSidecarImage": "gcr.io/queue"}, ""},
+		{"deployment", map[string]string{"registriesSkippingTagResolving": "ko.local"}, "Queue sidecar image is missing"},
+	} {
+		section, err := GetConfigSection(tc.section)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = section.Validate(tc.data)
+		switch {
+		case tc.err == "" && err != nil:
+			t.Errorf("unexpected error for %s %v: %v", tc.section, tc.data, err)
+		case tc.err != "" && (err == nil || !strings.Contains(err.Error(), tc.err)):
+			t.Errorf("expected error containing '%s' for %s %v, got %v", tc.err, tc.section, tc.data, err)
+		}
+	}
+}
+
+func TestConfigSectionCheckKeys(t *testing.T) {
+	section, err := GetConfigSection("gc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = section.CheckKeys([]string{"stale-revision-timeout"}, nil)
+	if err != nil {
+		t.Error(err)
+	}
+	err = section.CheckKeys([]string{"stale-revision-timeout", "stale-revision-timeouts", ConfigExampleKey}, nil)
+	if err == nil || !strings.HasPrefix(err.Error(), "unknown keys _example, stale-revision-timeouts in section 'gc', expected") {
+		t.Errorf("expected error for unknown keys, got %v", err)
+	}
+	// keys already set aren't checked, e.g. to remove outdated keys
+	err = section.CheckKeys([]string{"stale-revision-timeouts"}, map[string]string{"stale-revision-timeouts": "15h"})
+	if err != nil {
+		t.Error(err)
+	}
+}
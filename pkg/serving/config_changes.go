@@ -17,7 +17,9 @@ package serving
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
+	"github.com/knative/serving/pkg/apis/autoscaling"
 	servingv1alpha1 "github.com/knative/serving/pkg/apis/serving/v1alpha1"
 	servingv1beta1 "github.com/knative/serving/pkg/apis/serving/v1beta1"
 	corev1 "k8s.io/api/core/v1"
@@ -52,6 +54,20 @@ func UpdateConcurrencyConfiguration(template *servingv1alpha1.RevisionTemplateSp
 	}
 }
 
+// Get the concurrency limit and target of the given template in a short
+// form like "limit 10, target 5". An empty string is returned if neither
+// is configured.
+func ConcurrencyValue(template *servingv1alpha1.RevisionTemplateSpec) string {
+	var concurrency []string
+	if template.Spec.ContainerConcurrency != 0 {
+		concurrency = append(concurrency, fmt.Sprintf("limit %d", template.Spec.ContainerConcurrency))
+	}
+	if target := template.Annotations[autoscaling.TargetAnnotationKey]; target != "" {
+		concurrency = append(concurrency, "target "+target)
+	}
+	return strings.Join(concurrency, ", ")
+}
+
 // Updater (or add) an annotation to the given service
 func UpdateAnnotation(template *servingv1alpha1.RevisionTemplateSpec, annotation string, value string) {
 	annoMap := template.Annotations
@@ -41,6 +41,17 @@ func TestUpdateAutoscalingAnnotations(t *testing.T) {
 	}
 }
 
+func TestConcurrencyValue(t *testing.T) {
+	template := &servingv1alpha1.RevisionTemplateSpec{}
+	if value := ConcurrencyValue(template); value != "" {
+		t.Errorf("expected no concurrency, got %s", value)
+	}
+	UpdateConcurrencyConfiguration(template, 1, 0, 5, 10)
+	if value := ConcurrencyValue(template); value != "limit 10, target 5" {
+		t.Errorf("wrong concurrency %s", value)
+	}
+}
+
 func TestUpdateEnvVarsNew(t *testing.T) {
 	template, container := getV1alpha1RevisionTemplateWithOldFields()
 	testUpdateEnvVarsNew(t, template, container)
@@ -0,0 +1,35 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"fmt"
+
+	servingv1alpha1 "github.com/knative/serving/pkg/apis/serving/v1alpha1"
+)
+
+// Get the revision template of a configuration, preferring the v1beta1
+// field over the 'old' v1alpha1 field. The returned revision template can be
+// updated in place.
+// An error is returned if the configuration has no revision template
+func GetConfigurationRevisionTemplate(configuration *servingv1alpha1.Configuration) (*servingv1alpha1.RevisionTemplateSpec, error) {
+	if configuration.Spec.Template != nil {
+		return configuration.Spec.Template, nil
+	}
+	if configuration.Spec.DeprecatedRevisionTemplate != nil {
+		return configuration.Spec.DeprecatedRevisionTemplate, nil
+	}
+	return nil, fmt.Errorf("configuration '%s' does not specify a revision template", configuration.Name)
+}
@@ -0,0 +1,44 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"testing"
+
+	servingv1alpha1 "github.com/knative/serving/pkg/apis/serving/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetConfigurationRevisionTemplate(t *testing.T) {
+	configuration := &servingv1alpha1.Configuration{ObjectMeta: v1.ObjectMeta{Name: "foo"}}
+	_, err := GetConfigurationRevisionTemplate(configuration)
+	if err == nil || err.Error() != "configuration 'foo' does not specify a revision template" {
+		t.Fatalf("expected error for missing template, got %v", err)
+	}
+
+	oldTemplate, _ := getV1alpha1RevisionTemplateWithOldFields()
+	configuration.Spec.DeprecatedRevisionTemplate = oldTemplate
+	template, err := GetConfigurationRevisionTemplate(configuration)
+	if err != nil || template != oldTemplate {
+		t.Errorf("expected the v1alpha1 template, got %v (%v)", template, err)
+	}
+
+	newTemplate, _ := getV1alpha1Config()
+	configuration.Spec.Template = newTemplate
+	template, err = GetConfigurationRevisionTemplate(configuration)
+	if err != nil || template != newTemplate {
+		t.Errorf("expected the v1beta1 template, got %v (%v)", template, err)
+	}
+}
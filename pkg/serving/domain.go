@@ -0,0 +1,94 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/yaml"
+)
+
+// ConfigMap holding the domains of routes and the namespace it lives in
+const (
+	DomainConfigMapName = "config-domain"
+	ServingNamespace    = "knative-serving"
+)
+
+// Key in the domain ConfigMap holding documentation instead of a domain
+const domainExampleKey = "_example"
+
+// A domain used for the routes whose labels match the selector. An empty
+// selector matches all routes.
+type DomainRule struct {
+	Domain   string
+	Selector map[string]string
+}
+
+type domainSelector struct {
+	Selector map[string]string `json:"selector,omitempty"`
+}
+
+// Parse the data of the domain ConfigMap into rules sorted by domain
+func ParseDomainConfig(data map[string]string) ([]DomainRule, error) {
+	rules := make([]DomainRule, 0, len(data))
+	for domain, value := range data {
+		if domain == domainExampleKey {
+			continue
+		}
+		selector := domainSelector{}
+		err := yaml.Unmarshal([]byte(value), &selector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid selector of domain '%s': %v", domain, err)
+		}
+		rules = append(rules, DomainRule{Domain: domain, Selector: selector.Selector})
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].Domain < rules[j].Domain
+	})
+	return rules, nil
+}
+
+// Format a selector as value of the domain ConfigMap
+func FormatDomainSelector(selector map[string]string) (string, error) {
+	if len(selector) == 0 {
+		return "", nil
+	}
+	value, err := yaml.Marshal(domainSelector{Selector: selector})
+	if err != nil {
+		return "", err
+	}
+	return string(value), nil
+}
+
+// Find the domain of a route with the given labels. As done by the route
+// controller, the matching rule with the most specific selector wins, and
+// ties are broken by the domain name. An empty string is returned if no
+// rule matches.
+func DomainForLabels(rules []DomainRule, routeLabels map[string]string) string {
+	domain := ""
+	specificity := -1
+	for _, rule := range rules {
+		if !labels.SelectorFromSet(rule.Selector).Matches(labels.Set(routeLabels)) {
+			continue
+		}
+		if len(rule.Selector) > specificity || (len(rule.Selector) == specificity && rule.Domain < domain) {
+			domain = rule.Domain
+			specificity = len(rule.Selector)
+		}
+	}
+	return domain
+}
@@ -0,0 +1,87 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseDomainConfig(t *testing.T) {
+	rules, err := ParseDomainConfig(map[string]string{
+		"example.com":    "",
+		"prod.com":       "selector:\n  app: prod\n",
+		domainExampleKey: "documentation",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []DomainRule{
+		{Domain: "example.com"},
+		{Domain: "prod.com", Selector: map[string]string{"app": "prod"}},
+	}
+	if !reflect.DeepEqual(rules, expected) {
+		t.Errorf("wrong rules %v, expected %v", rules, expected)
+	}
+
+	_, err = ParseDomainConfig(map[string]string{"example.com": "selector: [a"})
+	if err == nil {
+		t.Error("expected error for invalid selector")
+	}
+}
+
+func TestFormatDomainSelector(t *testing.T) {
+	value, err := FormatDomainSelector(map[string]string{"app": "prod", "team": "a"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rules, err := ParseDomainConfig(map[string]string{"prod.com": value})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(rules[0].Selector, map[string]string{"app": "prod", "team": "a"}) {
+		t.Errorf("selector not preserved: %s", value)
+	}
+
+	value, err = FormatDomainSelector(nil)
+	if err != nil || value != "" {
+		t.Errorf("expected empty value for empty selector, got '%s' (%v)", value, err)
+	}
+}
+
+func TestDomainForLabels(t *testing.T) {
+	rules := []DomainRule{
+		{Domain: "b.com"},
+		{Domain: "a.com"},
+		{Domain: "prod.com", Selector: map[string]string{"app": "prod"}},
+		{Domain: "team.com", Selector: map[string]string{"app": "prod", "team": "a"}},
+	}
+	for _, tc := range []struct {
+		labels map[string]string
+		domain string
+	}{
+		{nil, "a.com"},
+		{map[string]string{"app": "dev"}, "a.com"},
+		{map[string]string{"app": "prod"}, "prod.com"},
+		{map[string]string{"app": "prod", "team": "a"}, "team.com"},
+	} {
+		if domain := DomainForLabels(rules, tc.labels); domain != tc.domain {
+			t.Errorf("wrong domain for %v: %s, expected %s", tc.labels, domain, tc.domain)
+		}
+	}
+	if domain := DomainForLabels(rules[2:], nil); domain != "" {
+		t.Errorf("expected no domain, got %s", domain)
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"fmt"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	servingv1alpha1 "github.com/knative/serving/pkg/apis/serving/v1alpha1"
+)
+
+// Annotation on the revision template recording the image reference as given
+// by the user, when the container image has been locked to a digest
+const UserImageAnnotationKey = "client.knative.dev/user-image"
+
+// Resolve an image reference to a reference by digest, using the credentials
+// from the docker config. References which are already given by digest are
+// returned unchanged. With insecure, the registry is accessed via plain HTTP.
+func ResolveImageDigest(image string, insecure bool) (string, error) {
+	opts := []name.Option{name.WeakValidation}
+	if insecure {
+		opts = append(opts, name.Insecure)
+	}
+	ref, err := name.ParseReference(image, opts...)
+	if err != nil {
+		return "", err
+	}
+	if _, ok := ref.(name.Digest); ok {
+		return image, nil
+	}
+	desc, err := remote.Get(ref, remote.WithAuthFromKeychain(authn.DefaultKeychain))
+	if err != nil {
+		return "", fmt.Errorf("cannot resolve digest of image '%s': %v", image, err)
+	}
+	return fmt.Sprintf("%s@%s", ref.Context().Name(), desc.Digest), nil
+}
+
+// Lock the container image to the given digest reference, recording the
+// image as given by the user in an annotation
+func UpdateImageWithDigest(template *servingv1alpha1.RevisionTemplateSpec, image string, digest string) error {
+	err := UpdateImage(template, digest)
+	if err != nil {
+		return err
+	}
+	if image == digest {
+		delete(template.Annotations, UserImageAnnotationKey)
+		return nil
+	}
+	UpdateAnnotation(template, UserImageAnnotationKey, image)
+	return nil
+}
+
+// Get the image as given by the user. This is either the image recorded
+// when locking to a digest, or the container image itself.
+func GetUserImage(template *servingv1alpha1.RevisionTemplateSpec) (string, error) {
+	if image, ok := template.Annotations[UserImageAnnotationKey]; ok {
+		return image, nil
+	}
+	container, err := extractContainer(template)
+	if err != nil {
+		return "", err
+	}
+	return container.Image, nil
+}
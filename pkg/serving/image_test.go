@@ -0,0 +1,80 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const testManifest = `{"schemaVersion":2,"mediaType":"application/vnd.docker.distribution.manifest.v2+json"}`
+
+func newTestRegistry() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/":
+			w.WriteHeader(http.StatusOK)
+		case "/v2/foo/bar/manifests/v1":
+			w.Header().Set("Content-Type", "application/vnd.docker.distribution.manifest.v2+json")
+			w.Write([]byte(testManifest))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestResolveImageDigest(t *testing.T) {
+	registry := newTestRegistry()
+	defer registry.Close()
+	host := strings.TrimPrefix(registry.URL, "http://")
+
+	digest, err := ResolveImageDigest(host+"/foo/bar:v1", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := fmt.Sprintf("%s/foo/bar@sha256:%x", host, sha256.Sum256([]byte(testManifest)))
+	if digest != expected {
+		t.Fatalf("wrong digest reference %s, expected %s", digest, expected)
+	}
+
+	_, err = ResolveImageDigest(host+"/foo/unknown:v1", false)
+	if err == nil {
+		t.Fatal("expected error for unknown image")
+	}
+
+	digest, err = ResolveImageDigest(expected, false)
+	if err != nil || digest != expected {
+		t.Fatalf("digest reference not returned unchanged: %s (%v)", digest, err)
+	}
+}
+
+func TestUpdateImageWithDigest(t *testing.T) {
+	template, container := getV1alpha1Config()
+	err := UpdateImageWithDigest(template, "gcr.io/foo/bar:v1", "gcr.io/foo/bar@sha256:abcd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if container.Image != "gcr.io/foo/bar@sha256:abcd" {
+		t.Fatalf("wrong image %s", container.Image)
+	}
+	image, err := GetUserImage(template)
+	if err != nil || image != "gcr.io/foo/bar:v1" {
+		t.Fatalf("wrong user image %s (%v)", image, err)
+	}
+}
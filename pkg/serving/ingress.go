@@ -0,0 +1,104 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"fmt"
+	"strings"
+
+	servingv1alpha1 "github.com/knative/serving/pkg/apis/serving/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+// Namespace and name of the Kubernetes service exposing the Istio ingress
+// gateway, which is the default entry point for Knative services
+const (
+	IngressGatewayNamespace = "istio-system"
+	IngressGatewayName      = "istio-ingressgateway"
+)
+
+// Get the host name under which the given service is reachable from outside.
+// The host is taken from the route status' URL and falls back to the
+// deprecated domain field for older serving versions.
+func GetServiceHost(service *servingv1alpha1.Service) (string, error) {
+	status := service.Status.RouteStatusFields
+	if status.URL != nil && status.URL.Host != "" {
+		return status.URL.Host, nil
+	}
+	if status.DeprecatedDomain != "" {
+		return status.DeprecatedDomain, nil
+	}
+	return "", fmt.Errorf("no domain assigned yet to service '%s'", service.Name)
+}
+
+// Get the host name under which the given service is reachable from within
+// the cluster
+func GetServiceInternalHost(service *servingv1alpha1.Service) (string, error) {
+	status := service.Status.RouteStatusFields
+	if status.Address != nil {
+		if status.Address.URL != nil && status.Address.URL.Host != "" {
+			return status.Address.URL.Host, nil
+		}
+		if status.Address.Hostname != "" {
+			return status.Address.Hostname, nil
+		}
+	}
+	if status.DeprecatedDomainInternal != "" {
+		return status.DeprecatedDomainInternal, nil
+	}
+	return "", fmt.Errorf("no internal address assigned yet to service '%s'", service.Name)
+}
+
+// Look up the external address of the ingress gateway.
+// The first IP or hostname found in the load balancer status of the
+// gateway's Kubernetes service is returned.
+func GetIngressAddress(client corev1.ServicesGetter) (string, error) {
+	gateway, err := client.Services(IngressGatewayNamespace).Get(IngressGatewayName, v1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	for _, ingress := range gateway.Status.LoadBalancer.Ingress {
+		if ingress.IP != "" {
+			return ingress.IP, nil
+		}
+		if ingress.Hostname != "" {
+			return ingress.Hostname, nil
+		}
+	}
+	return "", fmt.Errorf("no external address found for ingress gateway %s/%s", IngressGatewayNamespace, IngressGatewayName)
+}
+
+// Get the URL to send requests for the given service to and the host which
+// routes them to the service. The requests are sent to the given ingress
+// address or URL, or, if no ingress is given, to the address of the ingress
+// gateway looked up with the client. The returned URL has no trailing slash.
+func GetServiceRequestTarget(client corev1.ServicesGetter, service *servingv1alpha1.Service, ingress string) (string, string, error) {
+	host, err := GetServiceHost(service)
+	if err != nil {
+		return "", "", err
+	}
+	if ingress == "" {
+		ingress, err = GetIngressAddress(client)
+		if err != nil {
+			return "", "", fmt.Errorf("%v, use --ingress to specify the address of the ingress", err)
+		}
+	}
+	url := strings.TrimSuffix(ingress, "/")
+	if !strings.Contains(url, "://") {
+		url = "http://" + url
+	}
+	return url, host, nil
+}
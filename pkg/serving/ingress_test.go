@@ -0,0 +1,108 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"testing"
+
+	"github.com/knative/pkg/apis"
+	servingv1alpha1 "github.com/knative/serving/pkg/apis/serving/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/typed/core/v1/fake"
+	client_testing "k8s.io/client-go/testing"
+)
+
+func TestGetServiceHost(t *testing.T) {
+	service := &servingv1alpha1.Service{}
+	_, err := GetServiceHost(service)
+	if err == nil {
+		t.Fatal("expected error for service without domain")
+	}
+
+	service.Status.DeprecatedDomain = "foo.default.example.com"
+	host, err := GetServiceHost(service)
+	if err != nil || host != "foo.default.example.com" {
+		t.Fatalf("wrong host %s (%v)", host, err)
+	}
+
+	service.Status.URL = &apis.URL{Scheme: "http", Host: "foo.default.mydomain.com"}
+	host, err = GetServiceHost(service)
+	if err != nil || host != "foo.default.mydomain.com" {
+		t.Fatalf("wrong host %s (%v)", host, err)
+	}
+}
+
+func TestGetIngressAddress(t *testing.T) {
+	gateway := &corev1.Service{}
+	client := &fake.FakeCoreV1{Fake: &client_testing.Fake{}}
+	client.AddReactor("get", "services",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			getAction := a.(client_testing.GetAction)
+			if getAction.GetNamespace() != IngressGatewayNamespace || getAction.GetName() != IngressGatewayName {
+				t.Fatalf("wrong gateway requested: %s/%s", getAction.GetNamespace(), getAction.GetName())
+			}
+			return true, gateway, nil
+		})
+
+	_, err := GetIngressAddress(client)
+	if err == nil {
+		t.Fatal("expected error for gateway without load balancer")
+	}
+
+	gateway.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{{Hostname: "ingress.example.com"}}
+	address, err := GetIngressAddress(client)
+	if err != nil || address != "ingress.example.com" {
+		t.Fatalf("wrong address %s (%v)", address, err)
+	}
+
+	gateway.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{{IP: "10.0.0.1"}}
+	address, err = GetIngressAddress(client)
+	if err != nil || address != "10.0.0.1" {
+		t.Fatalf("wrong address %s (%v)", address, err)
+	}
+}
+
+func TestGetServiceRequestTarget(t *testing.T) {
+	gateway := &corev1.Service{}
+	gateway.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{{IP: "10.0.0.1"}}
+	client := &fake.FakeCoreV1{Fake: &client_testing.Fake{}}
+	client.AddReactor("get", "services",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			return true, gateway, nil
+		})
+
+	service := &servingv1alpha1.Service{}
+	_, _, err := GetServiceRequestTarget(client, service, "")
+	if err == nil {
+		t.Fatal("expected error for service without domain")
+	}
+
+	service.Status.URL = &apis.URL{Scheme: "http", Host: "foo.default.example.com"}
+	for ingress, expected := range map[string]string{
+		"":                        "http://10.0.0.1",
+		"192.168.99.100:31380":    "http://192.168.99.100:31380",
+		"https://ingress.local/":  "https://ingress.local",
+		"http://ingress.local:80": "http://ingress.local:80",
+	} {
+		url, host, err := GetServiceRequestTarget(client, service, ingress)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if url != expected || host != "foo.default.example.com" {
+			t.Errorf("wrong target %s with host %s for ingress '%s'", url, host, ingress)
+		}
+	}
+}
@@ -0,0 +1,214 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	servingv1alpha1 "github.com/knative/serving/pkg/apis/serving/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Kind of a probe on the container of a revision
+type ProbeKind string
+
+const (
+	ReadinessProbe ProbeKind = "readiness"
+	LivenessProbe  ProbeKind = "liveness"
+)
+
+// Parse a probe given as 'http:PATH[:PORT]', 'tcp[:PORT]' or 'exec:CMD'.
+// Knative Serving only allows probing the port of the container and sets
+// this port itself, so a given port must be equal to containerPort and is
+// not added to the probe.
+func ParseProbe(spec string, containerPort int32) (*corev1.Probe, error) {
+	kind := spec
+	arg := ""
+	if i := strings.Index(spec, ":"); i >= 0 {
+		kind, arg = spec[:i], spec[i+1:]
+	}
+
+	handler := corev1.Handler{}
+	switch kind {
+	case "http":
+		path := arg
+		// only a trailing number is a port, colons may be part of the path
+		if i := strings.LastIndex(arg, ":"); i >= 0 && isDigits(arg[i+1:]) {
+			if err := checkProbePort(arg[i+1:], containerPort); err != nil {
+				return nil, err
+			}
+			path = arg[:i]
+		}
+		if !strings.HasPrefix(path, "/") {
+			path = "/" + path
+		}
+		handler.HTTPGet = &corev1.HTTPGetAction{Path: path}
+	case "tcp":
+		if arg != "" {
+			if err := checkProbePort(arg, containerPort); err != nil {
+				return nil, err
+			}
+		}
+		handler.TCPSocket = &corev1.TCPSocketAction{}
+	case "exec":
+		command := strings.Fields(arg)
+		if len(command) == 0 {
+			return nil, fmt.Errorf("no command given for exec probe '%s'", spec)
+		}
+		handler.Exec = &corev1.ExecAction{Command: command}
+	default:
+		return nil, fmt.Errorf("invalid probe '%s', expected 'http:PATH[:PORT]', 'tcp[:PORT]' or 'exec:CMD'", spec)
+	}
+	return &corev1.Probe{Handler: handler}, nil
+}
+
+// Whether s is a non-empty string of decimal digits
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Update the timing of a probe from options given as comma separated
+// KEY=VALUE pairs. Supported keys are 'initial-delay', 'period' and 'timeout',
+// given in seconds or as duration (e.g. '10s'), as well as
+// 'success-threshold' and 'failure-threshold'.
+func UpdateProbeOptions(probe *corev1.Probe, options string) error {
+	for _, option := range strings.Split(options, ",") {
+		pair := strings.SplitN(option, "=", 2)
+		if len(pair) <= 1 {
+			return fmt.Errorf("probe option requires a value that contains the '=' character; got %s", option)
+		}
+		key, value := strings.TrimSpace(pair[0]), strings.TrimSpace(pair[1])
+
+		var target *int32
+		var parse func(string) (int32, error)
+		switch key {
+		case "initial-delay":
+			target, parse = &probe.InitialDelaySeconds, parseSeconds
+		case "period":
+			target, parse = &probe.PeriodSeconds, parseSeconds
+		case "timeout":
+			target, parse = &probe.TimeoutSeconds, parseSeconds
+		case "success-threshold":
+			target, parse = &probe.SuccessThreshold, parseCount
+		case "failure-threshold":
+			target, parse = &probe.FailureThreshold, parseCount
+		default:
+			return fmt.Errorf("unknown probe option '%s'", key)
+		}
+		parsed, err := parse(value)
+		if err != nil {
+			return fmt.Errorf("invalid value for probe option '%s': %v", key, err)
+		}
+		*target = parsed
+	}
+	return nil
+}
+
+// Get the probe of the given kind, or nil if no such probe is set
+func GetProbe(template *servingv1alpha1.RevisionTemplateSpec, kind ProbeKind) (*corev1.Probe, error) {
+	container, err := extractContainer(template)
+	if err != nil {
+		return nil, err
+	}
+	switch kind {
+	case ReadinessProbe:
+		return container.ReadinessProbe, nil
+	case LivenessProbe:
+		return container.LivenessProbe, nil
+	}
+	return nil, fmt.Errorf("internal: unknown probe kind '%s'", kind)
+}
+
+// Set the probe of the given kind. A nil probe removes the probe.
+func UpdateProbe(template *servingv1alpha1.RevisionTemplateSpec, kind ProbeKind, probe *corev1.Probe) error {
+	container, err := extractContainer(template)
+	if err != nil {
+		return err
+	}
+	switch kind {
+	case ReadinessProbe:
+		container.ReadinessProbe = probe
+	case LivenessProbe:
+		container.LivenessProbe = probe
+	default:
+		return fmt.Errorf("internal: unknown probe kind '%s'", kind)
+	}
+	return nil
+}
+
+// Get the port the container is listening on, which defaults to the
+// port used by Knative Serving if none is set
+func GetContainerPort(template *servingv1alpha1.RevisionTemplateSpec) (int32, error) {
+	container, err := extractContainer(template)
+	if err != nil {
+		return 0, err
+	}
+	if len(container.Ports) > 0 && container.Ports[0].ContainerPort != 0 {
+		return container.Ports[0].ContainerPort, nil
+	}
+	return servingv1alpha1.DefaultUserPort, nil
+}
+
+// =======================================================================================
+
+func checkProbePort(value string, containerPort int32) error {
+	port, err := strconv.ParseInt(value, 10, 32)
+	if err != nil || port <= 0 {
+		return fmt.Errorf("invalid probe port '%s'", value)
+	}
+	if int32(port) != containerPort {
+		return fmt.Errorf("probe port %d differs from container port %d, only the container port can be probed", port, containerPort)
+	}
+	return nil
+}
+
+func parseSeconds(value string) (int32, error) {
+	if seconds, err := strconv.ParseInt(value, 10, 32); err == nil {
+		if seconds < 0 {
+			return 0, fmt.Errorf("must not be negative")
+		}
+		return int32(seconds), nil
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+	if duration < 0 || duration%time.Second != 0 {
+		return 0, fmt.Errorf("must be a non-negative number of whole seconds")
+	}
+	return int32(duration / time.Second), nil
+}
+
+func parseCount(value string) (int32, error) {
+	count, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	if count < 1 {
+		return 0, fmt.Errorf("must be at least 1")
+	}
+	return int32(count), nil
+}
@@ -0,0 +1,131 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestParseProbe(t *testing.T) {
+	for _, tc := range []struct {
+		spec     string
+		expected corev1.Handler
+	}{
+		{"http:/healthz", corev1.Handler{HTTPGet: &corev1.HTTPGetAction{Path: "/healthz"}}},
+		{"http:ready:8080", corev1.Handler{HTTPGet: &corev1.HTTPGetAction{Path: "/ready"}}},
+		{"http:", corev1.Handler{HTTPGet: &corev1.HTTPGetAction{Path: "/"}}},
+		{"http:/v1/a:b", corev1.Handler{HTTPGet: &corev1.HTTPGetAction{Path: "/v1/a:b"}}},
+		{"http:/v1/a:b:8080", corev1.Handler{HTTPGet: &corev1.HTTPGetAction{Path: "/v1/a:b"}}},
+		{"tcp", corev1.Handler{TCPSocket: &corev1.TCPSocketAction{}}},
+		{"tcp:8080", corev1.Handler{TCPSocket: &corev1.TCPSocketAction{}}},
+		{"exec:cat /tmp/ready", corev1.Handler{Exec: &corev1.ExecAction{Command: []string{"cat", "/tmp/ready"}}}},
+	} {
+		probe, err := ParseProbe(tc.spec, 8080)
+		if err != nil {
+			t.Errorf("%s: %v", tc.spec, err)
+			continue
+		}
+		if !reflect.DeepEqual(probe.Handler, tc.expected) {
+			t.Errorf("%s: wrong handler %v", tc.spec, probe.Handler)
+		}
+	}
+}
+
+func TestParseProbeInvalid(t *testing.T) {
+	for _, tc := range []struct {
+		spec    string
+		message string
+	}{
+		{"grpc:8080", "invalid probe"},
+		{"exec:", "no command"},
+		{"http:/healthz:9090", "differs from container port 8080"},
+		{"tcp:9090", "differs from container port 8080"},
+		{"tcp:http", "invalid probe port"},
+	} {
+		_, err := ParseProbe(tc.spec, 8080)
+		if err == nil || !strings.Contains(err.Error(), tc.message) {
+			t.Errorf("%s: expected error containing '%s', got %v", tc.spec, tc.message, err)
+		}
+	}
+}
+
+func TestUpdateProbeOptions(t *testing.T) {
+	probe := &corev1.Probe{}
+	err := UpdateProbeOptions(probe, "initial-delay=5,period=1m,timeout=2s,success-threshold=1,failure-threshold=6")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := &corev1.Probe{
+		InitialDelaySeconds: 5,
+		PeriodSeconds:       60,
+		TimeoutSeconds:      2,
+		SuccessThreshold:    1,
+		FailureThreshold:    6,
+	}
+	if !reflect.DeepEqual(probe, expected) {
+		t.Errorf("wrong probe options %v", probe)
+	}
+
+	for _, options := range []string{"period", "delay=5", "timeout=1.5s", "period=-1", "failure-threshold=0"} {
+		if err := UpdateProbeOptions(&corev1.Probe{}, options); err == nil {
+			t.Errorf("%s: expected an error", options)
+		}
+	}
+}
+
+func TestUpdateProbe(t *testing.T) {
+	template, container := getV1alpha1Config()
+	container.Ports = []corev1.ContainerPort{{ContainerPort: 9000}}
+	port, err := GetContainerPort(template)
+	if err != nil || port != 9000 {
+		t.Fatalf("expected container port 9000, got %d (%v)", port, err)
+	}
+
+	probe, err := ParseProbe("tcp:9000", port)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = UpdateProbe(template, ReadinessProbe, probe)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if container.ReadinessProbe != probe || container.LivenessProbe != nil {
+		t.Errorf("readiness probe not set: %v", container)
+	}
+	got, err := GetProbe(template, ReadinessProbe)
+	if err != nil || got != probe {
+		t.Errorf("wrong readiness probe %v (%v)", got, err)
+	}
+
+	err = UpdateProbe(template, ReadinessProbe, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if container.ReadinessProbe != nil {
+		t.Errorf("readiness probe not removed: %v", container.ReadinessProbe)
+	}
+}
+
+func TestGetContainerPortDefault(t *testing.T) {
+	template, _ := getV1alpha1RevisionTemplateWithOldFields()
+	port, err := GetContainerPort(template)
+	if err != nil || port != 8080 {
+		t.Errorf("expected default port 8080, got %d (%v)", port, err)
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/knative/serving/pkg/apis/serving"
+	servingv1alpha1 "github.com/knative/serving/pkg/apis/serving/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Get the container of a revision, using either the new v1beta1 or the
+// 'old' v1alpha1 field
+func GetRevisionContainer(revision *servingv1alpha1.Revision) (*corev1.Container, error) {
+	return extractContainer(&servingv1alpha1.RevisionTemplateSpec{Spec: revision.Spec})
+}
+
+// Get the names of the revisions a service depends on. These are the
+// revisions which receive traffic or are referenced in the service's spec,
+// as well as the latest created and the latest ready revision. Revisions
+// targeted by any of the given routes, e.g. standalone routes in the
+// service's namespace, are in use as well.
+func RevisionsInUse(service *servingv1alpha1.Service, routes []servingv1alpha1.Route) map[string]bool {
+	inUse := map[string]bool{}
+	add := func(name string) {
+		if name != "" && name != servingv1alpha1.ReleaseLatestRevisionKeyword {
+			inUse[name] = true
+		}
+	}
+
+	status := service.Status
+	add(status.LatestCreatedRevisionName)
+	add(status.LatestReadyRevisionName)
+	for _, target := range status.Traffic {
+		add(target.RevisionName)
+	}
+
+	spec := service.Spec
+	for _, target := range spec.Traffic {
+		add(target.RevisionName)
+	}
+	if spec.DeprecatedPinned != nil {
+		add(spec.DeprecatedPinned.RevisionName)
+	}
+	if spec.DeprecatedRelease != nil {
+		for _, name := range spec.DeprecatedRelease.Revisions {
+			add(name)
+		}
+	}
+
+	for _, route := range routes {
+		for _, target := range route.Spec.Traffic {
+			add(target.RevisionName)
+		}
+		for _, target := range route.Status.Traffic {
+			add(target.RevisionName)
+		}
+	}
+	return inUse
+}
+
+// Get the generation of a revision within its configuration, as recorded
+// in the revision's labels. 0 is returned if the generation is unknown.
+func RevisionGeneration(revision *servingv1alpha1.Revision) int64 {
+	generation, err := strconv.ParseInt(revision.Labels[serving.ConfigurationGenerationLabelKey], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return generation
+}
+
+// Sort revisions by their generation, newest first. Revisions with the
+// same or an unknown generation are sorted by their creation time.
+func SortRevisionsByGeneration(revisions []servingv1alpha1.Revision) {
+	sort.SliceStable(revisions, func(i, j int) bool {
+		gi, gj := RevisionGeneration(&revisions[i]), RevisionGeneration(&revisions[j])
+		if gi != gj {
+			return gi > gj
+		}
+		return revisions[j].CreationTimestamp.Before(&revisions[i].CreationTimestamp)
+	})
+}
+
+// Select the revisions of a service which can be deleted. The newest keep
+// revisions are retained, as are revisions younger than olderThan and the
+// revisions in use by the service or targeted by the given routes.
+func PruneCandidates(service *servingv1alpha1.Service, routes []servingv1alpha1.Route, revisions []servingv1alpha1.Revision, keep int, olderThan time.Duration, now time.Time) []servingv1alpha1.Revision {
+	sorted := make([]servingv1alpha1.Revision, len(revisions))
+	copy(sorted, revisions)
+	SortRevisionsByGeneration(sorted)
+
+	inUse := RevisionsInUse(service, routes)
+	var candidates []servingv1alpha1.Revision
+	for i, revision := range sorted {
+		if i < keep || inUse[revision.Name] {
+			continue
+		}
+		if olderThan > 0 && now.Sub(revision.CreationTimestamp.Time) < olderThan {
+			continue
+		}
+		candidates = append(candidates, revision)
+	}
+	return candidates
+}
+
+// Share of traffic a revision receives and the tags it's addressable by
+type RevisionTraffic struct {
+	Percent int
+	Tags    []string
+}
+
+// Sum up the traffic of the given traffic targets by revision name. Only
+// targets which have been resolved to a revision are taken into account.
+func GetRevisionTraffic(targets []servingv1alpha1.TrafficTarget) map[string]*RevisionTraffic {
+	traffic := map[string]*RevisionTraffic{}
+	for _, target := range targets {
+		if target.RevisionName == "" {
+			continue
+		}
+		revisionTraffic := traffic[target.RevisionName]
+		if revisionTraffic == nil {
+			revisionTraffic = &RevisionTraffic{}
+			traffic[target.RevisionName] = revisionTraffic
+		}
+		revisionTraffic.Percent += target.Percent
+		if target.Tag != "" {
+			revisionTraffic.Tags = append(revisionTraffic.Tags, target.Tag)
+		}
+	}
+	return traffic
+}
+
+// Traffic a route sends to a revision
+type RouteTraffic struct {
+	Route string
+	RevisionTraffic
+}
+
+// Get the traffic each of the given routes sends to the revision, ordered
+// like the routes. The shares of different routes are independent of each
+// other, so they are reported separately instead of being added up.
+func GetRoutesTraffic(routes []servingv1alpha1.Route, revision *servingv1alpha1.Revision) []RouteTraffic {
+	var traffic []RouteTraffic
+	for _, route := range routes {
+		if route.Namespace != revision.Namespace {
+			continue
+		}
+		if revisionTraffic := GetRevisionTraffic(route.Status.Traffic)[revision.Name]; revisionTraffic != nil {
+			traffic = append(traffic, RouteTraffic{route.Name, *revisionTraffic})
+		}
+	}
+	return traffic
+}
+
+// Get the traffic a revision receives from the route of the service it
+// belongs to, found among the given routes by its service label. Nil is
+// returned if the revision isn't part of a service or receives no traffic.
+func GetServiceTraffic(routes []servingv1alpha1.Route, revision *servingv1alpha1.Revision) *RevisionTraffic {
+	service := revision.Labels[serving.ServiceLabelKey]
+	if service == "" {
+		return nil
+	}
+	for _, route := range routes {
+		if route.Namespace == revision.Namespace && route.Labels[serving.ServiceLabelKey] == service {
+			return GetRevisionTraffic(route.Status.Traffic)[revision.Name]
+		}
+	}
+	return nil
+}
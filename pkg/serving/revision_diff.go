@@ -0,0 +1,245 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/knative/serving/pkg/apis/serving"
+	servingv1alpha1 "github.com/knative/serving/pkg/apis/serving/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Categories of differences between revisions, in the order they are
+// reported
+const (
+	DiffCategoryImage       = "Image"
+	DiffCategoryEnv         = "Env"
+	DiffCategoryResources   = "Resources"
+	DiffCategoryContainer   = "Container"
+	DiffCategoryRevision    = "Revision"
+	DiffCategoryAnnotations = "Annotations"
+)
+
+// Annotations which change with every revision without affecting it
+var volatileRevisionAnnotations = map[string]bool{
+	serving.CreatorAnnotation:               true,
+	serving.UpdaterAnnotation:               true,
+	serving.RevisionLastPinnedAnnotationKey: true,
+}
+
+// A difference between two revisions. An empty value means that the field
+// is not set in the respective revision.
+type RevisionDifference struct {
+	Category string
+	Field    string
+	Value1   string
+	Value2   string
+}
+
+// Compare two revisions semantically. Environment variables and
+// annotations are compared as maps and resource quantities by their value.
+// The images are considered equal if they resolved to the same digest,
+// regardless of whether they were given by tag or by digest.
+func DiffRevisions(revision1, revision2 *servingv1alpha1.Revision) ([]RevisionDifference, error) {
+	container1, err := GetRevisionContainer(revision1)
+	if err != nil {
+		return nil, err
+	}
+	container2, err := GetRevisionContainer(revision2)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &revisionDiffer{}
+	digest1, digest2 := revision1.Status.ImageDigest, revision2.Status.ImageDigest
+	if digest1 == "" || digest2 == "" || digest1 != digest2 {
+		d.add(DiffCategoryImage, "image", container1.Image, container2.Image)
+		d.add(DiffCategoryImage, "digest", digest1, digest2)
+	}
+
+	env1, err := envToDiffMap(container1.Env)
+	if err != nil {
+		return nil, err
+	}
+	env2, err := envToDiffMap(container2.Env)
+	if err != nil {
+		return nil, err
+	}
+	d.addMap(DiffCategoryEnv, env1, env2)
+
+	d.addResources("requests", container1.Resources.Requests, container2.Resources.Requests)
+	d.addResources("limits", container1.Resources.Limits, container2.Resources.Limits)
+
+	d.add(DiffCategoryContainer, "command", strings.Join(container1.Command, " "), strings.Join(container2.Command, " "))
+	d.add(DiffCategoryContainer, "args", strings.Join(container1.Args, " "), strings.Join(container2.Args, " "))
+	d.add(DiffCategoryContainer, "ports", formatPorts(container1.Ports), formatPorts(container2.Ports))
+	d.add(DiffCategoryContainer, "readinessProbe", formatProbe(container1.ReadinessProbe), formatProbe(container2.ReadinessProbe))
+	d.add(DiffCategoryContainer, "livenessProbe", formatProbe(container1.LivenessProbe), formatProbe(container2.LivenessProbe))
+
+	spec1, spec2 := revision1.Spec, revision2.Spec
+	d.add(DiffCategoryRevision, "containerConcurrency", formatInt(int64(spec1.ContainerConcurrency)), formatInt(int64(spec2.ContainerConcurrency)))
+	d.add(DiffCategoryRevision, "timeoutSeconds", formatOptionalInt(spec1.TimeoutSeconds), formatOptionalInt(spec2.TimeoutSeconds))
+	d.add(DiffCategoryRevision, "serviceAccountName", spec1.ServiceAccountName, spec2.ServiceAccountName)
+
+	d.addMap(DiffCategoryAnnotations, stableAnnotations(revision1.Annotations), stableAnnotations(revision2.Annotations))
+	return d.differences, nil
+}
+
+// =======================================================================================
+
+type revisionDiffer struct {
+	differences []RevisionDifference
+}
+
+func (d *revisionDiffer) add(category, field, value1, value2 string) {
+	if value1 != value2 {
+		d.differences = append(d.differences, RevisionDifference{category, field, value1, value2})
+	}
+}
+
+func (d *revisionDiffer) addMap(category string, map1, map2 map[string]string) {
+	keys := map[string]bool{}
+	for key := range map1 {
+		keys[key] = true
+	}
+	for key := range map2 {
+		keys[key] = true
+	}
+	sorted := make([]string, 0, len(keys))
+	for key := range keys {
+		sorted = append(sorted, key)
+	}
+	sort.Strings(sorted)
+	for _, key := range sorted {
+		d.add(category, key, map1[key], map2[key])
+	}
+}
+
+func (d *revisionDiffer) addResources(kind string, list1, list2 corev1.ResourceList) {
+	names := map[corev1.ResourceName]bool{}
+	for name := range list1 {
+		names[name] = true
+	}
+	for name := range list2 {
+		names[name] = true
+	}
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, string(name))
+	}
+	sort.Strings(sorted)
+	for _, name := range sorted {
+		quantity1, ok1 := list1[corev1.ResourceName(name)]
+		quantity2, ok2 := list2[corev1.ResourceName(name)]
+		if ok1 && ok2 && quantity1.Cmp(quantity2) == 0 {
+			continue
+		}
+		value1, value2 := "", ""
+		if ok1 {
+			value1 = quantity1.String()
+		}
+		if ok2 {
+			value2 = quantity2.String()
+		}
+		d.differences = append(d.differences, RevisionDifference{DiffCategoryResources, kind + "." + name, value1, value2})
+	}
+}
+
+func stableAnnotations(annotations map[string]string) map[string]string {
+	stable := map[string]string{}
+	for key, value := range annotations {
+		if !volatileRevisionAnnotations[key] {
+			stable[key] = value
+		}
+	}
+	return stable
+}
+
+// Like EnvToMap, but variables taking their value from a reference are
+// mapped to the formatted reference
+func envToDiffMap(vars []corev1.EnvVar) (map[string]string, error) {
+	env, err := EnvToMap(vars)
+	if err != nil {
+		return nil, err
+	}
+	for _, envVar := range vars {
+		if envVar.ValueFrom != nil {
+			env[envVar.Name] = formatEnvVarSource(envVar.ValueFrom)
+		}
+	}
+	return env, nil
+}
+
+func formatEnvVarSource(source *corev1.EnvVarSource) string {
+	switch {
+	case source.SecretKeyRef != nil:
+		return "secretKeyRef:" + source.SecretKeyRef.Name + "/" + source.SecretKeyRef.Key
+	case source.ConfigMapKeyRef != nil:
+		return "configMapKeyRef:" + source.ConfigMapKeyRef.Name + "/" + source.ConfigMapKeyRef.Key
+	case source.FieldRef != nil:
+		return "fieldRef:" + source.FieldRef.FieldPath
+	case source.ResourceFieldRef != nil:
+		return "resourceFieldRef:" + path.Join(source.ResourceFieldRef.ContainerName, source.ResourceFieldRef.Resource)
+	}
+	return ""
+}
+
+func formatPorts(ports []corev1.ContainerPort) string {
+	formatted := make([]string, 0, len(ports))
+	for _, port := range ports {
+		value := strconv.Itoa(int(port.ContainerPort))
+		if port.Name != "" {
+			value = port.Name + ":" + value
+		}
+		formatted = append(formatted, value)
+	}
+	return strings.Join(formatted, ",")
+}
+
+func formatProbe(probe *corev1.Probe) string {
+	if probe == nil {
+		return ""
+	}
+	var handler string
+	switch {
+	case probe.HTTPGet != nil:
+		handler = "http:" + probe.HTTPGet.Path
+	case probe.TCPSocket != nil:
+		handler = "tcp"
+	case probe.Exec != nil:
+		handler = "exec:" + strings.Join(probe.Exec.Command, " ")
+	}
+	return fmt.Sprintf("%s initial-delay=%d,period=%d,timeout=%d,success-threshold=%d,failure-threshold=%d",
+		handler, probe.InitialDelaySeconds, probe.PeriodSeconds, probe.TimeoutSeconds, probe.SuccessThreshold, probe.FailureThreshold)
+}
+
+func formatInt(value int64) string {
+	if value == 0 {
+		return ""
+	}
+	return strconv.FormatInt(value, 10)
+}
+
+func formatOptionalInt(value *int64) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.FormatInt(*value, 10)
+}
@@ -0,0 +1,112 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/knative/serving/pkg/apis/serving"
+	servingv1alpha1 "github.com/knative/serving/pkg/apis/serving/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func newDiffRevision(image, digest string, env map[string]string, memory string, annotations map[string]string) *servingv1alpha1.Revision {
+	container := &corev1.Container{
+		Image: image,
+		Resources: corev1.ResourceRequirements{
+			Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse(memory)},
+		},
+	}
+	container.Env = updateEnvVarsFromMap(container.Env, env)
+	revision := &servingv1alpha1.Revision{}
+	revision.Annotations = annotations
+	revision.Spec.DeprecatedContainer = container
+	revision.Status.ImageDigest = digest
+	return revision
+}
+
+func TestDiffRevisionsEqual(t *testing.T) {
+	revision1 := newDiffRevision("gcr.io/foo/bar:v1", "gcr.io/foo/bar@sha256:abc",
+		map[string]string{"A": "1", "B": "2"}, "1Gi", map[string]string{serving.CreatorAnnotation: "alice"})
+	revision2 := newDiffRevision("gcr.io/foo/bar@sha256:abc", "gcr.io/foo/bar@sha256:abc",
+		map[string]string{"B": "2", "A": "1"}, "1024Mi", map[string]string{serving.CreatorAnnotation: "bob"})
+
+	differences, err := DiffRevisions(revision1, revision2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(differences) != 0 {
+		t.Errorf("expected no differences, got %v", differences)
+	}
+}
+
+func TestDiffRevisions(t *testing.T) {
+	revision1 := newDiffRevision("gcr.io/foo/bar:v2", "gcr.io/foo/bar@sha256:def",
+		map[string]string{"A": "1", "C": "3"}, "2Gi", map[string]string{"autoscaling.knative.dev/maxScale": "5"})
+	revision1.Spec.ContainerConcurrency = 10
+	revision2 := newDiffRevision("gcr.io/foo/bar:v1", "gcr.io/foo/bar@sha256:abc",
+		map[string]string{"A": "0", "B": "2"}, "1Gi", nil)
+
+	differences, err := DiffRevisions(revision1, revision2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []RevisionDifference{
+		{DiffCategoryImage, "image", "gcr.io/foo/bar:v2", "gcr.io/foo/bar:v1"},
+		{DiffCategoryImage, "digest", "gcr.io/foo/bar@sha256:def", "gcr.io/foo/bar@sha256:abc"},
+		{DiffCategoryEnv, "A", "1", "0"},
+		{DiffCategoryEnv, "B", "", "2"},
+		{DiffCategoryEnv, "C", "3", ""},
+		{DiffCategoryResources, "limits.memory", "2Gi", "1Gi"},
+		{DiffCategoryRevision, "containerConcurrency", "10", ""},
+		{DiffCategoryAnnotations, "autoscaling.knative.dev/maxScale", "5", ""},
+	}
+	if !reflect.DeepEqual(differences, expected) {
+		t.Errorf("wrong differences:\n%v\nexpected:\n%v", differences, expected)
+	}
+}
+
+func TestDiffRevisionsEnvValueFrom(t *testing.T) {
+	revision1 := newDiffRevision("gcr.io/foo/bar:v1", "gcr.io/foo/bar@sha256:abc", nil, "1Gi", nil)
+	revision1.Spec.DeprecatedContainer.Env = []corev1.EnvVar{
+		{Name: "PASSWORD", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "creds-v1"}, Key: "password"}}},
+		{Name: "CONFIG", ValueFrom: &corev1.EnvVarSource{ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "config"}, Key: "app.yaml"}}},
+		{Name: "NODE", ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "spec.nodeName"}}},
+	}
+	revision2 := newDiffRevision("gcr.io/foo/bar:v1", "gcr.io/foo/bar@sha256:abc", nil, "1Gi", nil)
+	revision2.Spec.DeprecatedContainer.Env = []corev1.EnvVar{
+		{Name: "PASSWORD", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "creds-v2"}, Key: "password"}}},
+		{Name: "CONFIG", ValueFrom: &corev1.EnvVarSource{ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "config"}, Key: "app.yaml"}}},
+		{Name: "NODE", Value: "node-1"},
+	}
+
+	differences, err := DiffRevisions(revision1, revision2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []RevisionDifference{
+		{DiffCategoryEnv, "NODE", "fieldRef:spec.nodeName", "node-1"},
+		{DiffCategoryEnv, "PASSWORD", "secretKeyRef:creds-v1/password", "secretKeyRef:creds-v2/password"},
+	}
+	if !reflect.DeepEqual(differences, expected) {
+		t.Errorf("wrong differences:\n%v\nexpected:\n%v", differences, expected)
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/knative/serving/pkg/apis/serving"
+	servingv1alpha1 "github.com/knative/serving/pkg/apis/serving/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newRevision(name, generation string, created time.Time) servingv1alpha1.Revision {
+	return servingv1alpha1.Revision{
+		ObjectMeta: v1.ObjectMeta{
+			Name:              name,
+			Labels:            map[string]string{serving.ConfigurationGenerationLabelKey: generation},
+			CreationTimestamp: v1.NewTime(created),
+		},
+	}
+}
+
+func revisionNames(revisions []servingv1alpha1.Revision) []string {
+	var names []string
+	for _, revision := range revisions {
+		names = append(names, revision.Name)
+	}
+	return names
+}
+
+func TestRevisionsInUse(t *testing.T) {
+	service := &servingv1alpha1.Service{}
+	service.Status.LatestCreatedRevisionName = "foo-3"
+	service.Status.LatestReadyRevisionName = "foo-2"
+	service.Status.Traffic = []servingv1alpha1.TrafficTarget{{}}
+	service.Status.Traffic[0].RevisionName = "foo-1"
+	service.Spec.DeprecatedRelease = &servingv1alpha1.ReleaseType{
+		Revisions: []string{"foo-0", servingv1alpha1.ReleaseLatestRevisionKeyword},
+	}
+
+	route := servingv1alpha1.Route{}
+	route.Spec.Traffic = []servingv1alpha1.TrafficTarget{{}}
+	route.Spec.Traffic[0].RevisionName = "foo-4"
+	route.Status.Traffic = []servingv1alpha1.TrafficTarget{{}, {}}
+	route.Status.Traffic[0].RevisionName = "foo-5"
+	route.Status.Traffic[1].ConfigurationName = "foo"
+
+	expected := map[string]bool{"foo-0": true, "foo-1": true, "foo-2": true, "foo-3": true, "foo-4": true, "foo-5": true}
+	if inUse := RevisionsInUse(service, []servingv1alpha1.Route{route}); !reflect.DeepEqual(inUse, expected) {
+		t.Errorf("wrong revisions in use: %v", inUse)
+	}
+}
+
+func TestSortRevisionsByGeneration(t *testing.T) {
+	now := time.Now()
+	revisions := []servingv1alpha1.Revision{
+		newRevision("a", "2", now),
+		newRevision("b", "10", now.Add(-time.Hour)),
+		newRevision("c", "", now.Add(-time.Minute)),
+		newRevision("d", "", now),
+	}
+	SortRevisionsByGeneration(revisions)
+	if names := revisionNames(revisions); !reflect.DeepEqual(names, []string{"b", "a", "d", "c"}) {
+		t.Errorf("wrong order: %v", names)
+	}
+}
+
+func TestPruneCandidates(t *testing.T) {
+	now := time.Now()
+	revisions := []servingv1alpha1.Revision{
+		newRevision("foo-1", "1", now.Add(-72*time.Hour)),
+		newRevision("foo-2", "2", now.Add(-48*time.Hour)),
+		newRevision("foo-3", "3", now.Add(-24*time.Hour)),
+		newRevision("foo-4", "4", now),
+	}
+	service := &servingv1alpha1.Service{}
+	service.Status.LatestCreatedRevisionName = "foo-4"
+	service.Status.LatestReadyRevisionName = "foo-4"
+	service.Status.Traffic = []servingv1alpha1.TrafficTarget{{}}
+	service.Status.Traffic[0].RevisionName = "foo-1"
+
+	for _, tc := range []struct {
+		keep      int
+		olderThan time.Duration
+		expected  []string
+	}{
+		{0, 0, []string{"foo-3", "foo-2"}},
+		{2, 0, []string{"foo-2"}},
+		{0, 36 * time.Hour, []string{"foo-2"}},
+		{5, 0, nil},
+	} {
+		candidates := PruneCandidates(service, nil, revisions, tc.keep, tc.olderThan, now)
+		if names := revisionNames(candidates); !reflect.DeepEqual(names, tc.expected) {
+			t.Errorf("keep %d, older than %v: expected %v, got %v", tc.keep, tc.olderThan, tc.expected, names)
+		}
+	}
+	if names := revisionNames(revisions); names[0] != "foo-1" {
+		t.Errorf("revisions modified in place: %v", names)
+	}
+}
+
+func TestGetRevisionTraffic(t *testing.T) {
+	targets := make([]servingv1alpha1.TrafficTarget, 4)
+	targets[0].RevisionName, targets[0].Percent = "foo-1", 80
+	targets[1].RevisionName, targets[1].Percent, targets[1].Tag = "foo-2", 20, "candidate"
+	targets[2].RevisionName, targets[2].Tag = "foo-1", "current"
+	targets[3].ConfigurationName, targets[3].Percent = "foo", 100
+
+	expected := map[string]*RevisionTraffic{
+		"foo-1": {Percent: 80, Tags: []string{"current"}},
+		"foo-2": {Percent: 20, Tags: []string{"candidate"}},
+	}
+	if traffic := GetRevisionTraffic(targets); !reflect.DeepEqual(traffic, expected) {
+		t.Errorf("wrong traffic: %v", traffic)
+	}
+}
+
+func TestGetRoutesTraffic(t *testing.T) {
+	revision := &servingv1alpha1.Revision{}
+	revision.Name, revision.Namespace = "foo-1", "default"
+	routes := make([]servingv1alpha1.Route, 3)
+	routes[0].Name, routes[0].Namespace = "foo", "default"
+	routes[0].Status.Traffic = make([]servingv1alpha1.TrafficTarget, 2)
+	routes[0].Status.Traffic[0].RevisionName, routes[0].Status.Traffic[0].Percent = "foo-1", 50
+	routes[0].Status.Traffic[1].RevisionName, routes[0].Status.Traffic[1].Percent = "foo-2", 50
+	routes[1].Name, routes[1].Namespace = "pinned", "default"
+	routes[1].Status.Traffic = make([]servingv1alpha1.TrafficTarget, 1)
+	routes[1].Status.Traffic[0].RevisionName, routes[1].Status.Traffic[0].Percent, routes[1].Status.Traffic[0].Tag = "foo-1", 100, "pinned"
+	routes[2].Name, routes[2].Namespace = "foo", "other"
+	routes[2].Status.Traffic = make([]servingv1alpha1.TrafficTarget, 1)
+	routes[2].Status.Traffic[0].RevisionName, routes[2].Status.Traffic[0].Percent = "foo-1", 100
+
+	expected := []RouteTraffic{
+		{"foo", RevisionTraffic{Percent: 50}},
+		{"pinned", RevisionTraffic{Percent: 100, Tags: []string{"pinned"}}},
+	}
+	if traffic := GetRoutesTraffic(routes, revision); !reflect.DeepEqual(traffic, expected) {
+		t.Errorf("wrong traffic: %v", traffic)
+	}
+}
+
+func TestGetServiceTraffic(t *testing.T) {
+	revision := &servingv1alpha1.Revision{}
+	revision.Name, revision.Namespace = "foo-1", "default"
+	routes := make([]servingv1alpha1.Route, 3)
+	routes[0].Name, routes[0].Namespace = "pinned", "default"
+	routes[0].Status.Traffic = make([]servingv1alpha1.TrafficTarget, 1)
+	routes[0].Status.Traffic[0].RevisionName, routes[0].Status.Traffic[0].Percent = "foo-1", 100
+	routes[1].Name, routes[1].Namespace = "foo", "other"
+	routes[1].Labels = map[string]string{serving.ServiceLabelKey: "foo"}
+	routes[1].Status.Traffic = make([]servingv1alpha1.TrafficTarget, 1)
+	routes[1].Status.Traffic[0].RevisionName, routes[1].Status.Traffic[0].Percent = "foo-1", 100
+	routes[2].Name, routes[2].Namespace = "foo", "default"
+	routes[2].Labels = map[string]string{serving.ServiceLabelKey: "foo"}
+	routes[2].Status.Traffic = make([]servingv1alpha1.TrafficTarget, 2)
+	routes[2].Status.Traffic[0].RevisionName, routes[2].Status.Traffic[0].Percent = "foo-1", 40
+	routes[2].Status.Traffic[1].RevisionName, routes[2].Status.Traffic[1].Percent = "foo-2", 60
+
+	if traffic := GetServiceTraffic(routes, revision); traffic != nil {
+		t.Errorf("unexpected traffic for revision without service: %v", traffic)
+	}
+	revision.Labels = map[string]string{serving.ServiceLabelKey: "foo"}
+	expected := &RevisionTraffic{Percent: 40}
+	if traffic := GetServiceTraffic(routes, revision); !reflect.DeepEqual(traffic, expected) {
+		t.Errorf("wrong traffic: %v", traffic)
+	}
+}
@@ -17,7 +17,16 @@ package serving
 import (
 	"errors"
 
+	"github.com/knative/serving/pkg/apis/serving"
 	servingv1alpha1 "github.com/knative/serving/pkg/apis/serving/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Label which restricts the visibility of a service's route, and its value
+// for making a service reachable only from within the cluster
+const (
+	VisibilityLabelKey     = "serving.knative.dev/visibility"
+	VisibilityClusterLocal = "cluster-local"
 )
 
 // Get the revision template associated with a service.
@@ -49,3 +58,86 @@ func getConfiguration(service *servingv1alpha1.Service) (*servingv1alpha1.Config
 		return nil, errors.New("service does not specify a Configuration")
 	}
 }
+
+// Check whether the service is only reachable from within the cluster
+func IsClusterLocal(service *servingv1alpha1.Service) bool {
+	return service.Labels[VisibilityLabelKey] == VisibilityClusterLocal
+}
+
+// Set or remove the label which makes the service reachable only from
+// within the cluster
+func UpdateClusterLocal(service *servingv1alpha1.Service, clusterLocal bool) {
+	if clusterLocal {
+		if service.Labels == nil {
+			service.Labels = make(map[string]string)
+		}
+		service.Labels[VisibilityLabelKey] = VisibilityClusterLocal
+	} else {
+		delete(service.Labels, VisibilityLabelKey)
+	}
+}
+
+// Annotations which are bound to the identity of a service and are not
+// taken over when copying it
+var identityAnnotations = []string{
+	serving.CreatorAnnotation,
+	serving.UpdaterAnnotation,
+	"kubectl.kubernetes.io/last-applied-configuration",
+}
+
+// Create a copy of the given service under a new namespace and name.
+// Identity, status and references to revisions of the original service are
+// stripped, so that the copy can be created as a fresh service. Traffic
+// which was routed to specific revisions is routed to the latest revision
+// of the copy.
+func CopyService(service *servingv1alpha1.Service, namespace, name string) (*servingv1alpha1.Service, error) {
+	source := service.DeepCopy()
+	target := &servingv1alpha1.Service{
+		ObjectMeta: v1.ObjectMeta{
+			Name:        name,
+			Namespace:   namespace,
+			Labels:      source.Labels,
+			Annotations: source.Annotations,
+		},
+		Spec: source.Spec,
+	}
+	for _, annotation := range identityAnnotations {
+		delete(target.Annotations, annotation)
+	}
+
+	spec := &target.Spec
+	spec.DeprecatedGeneration = 0
+	spec.Traffic = nil
+	if spec.Template != nil {
+		// Revision names have to be unique, so let them be generated again
+		spec.Template.Name = ""
+	} else {
+		config, err := getConfiguration(target)
+		if err != nil {
+			return nil, err
+		}
+		runLatest := &servingv1alpha1.RunLatestType{Configuration: *config}
+		if runLatest.Configuration.DeprecatedRevisionTemplate != nil {
+			runLatest.Configuration.DeprecatedRevisionTemplate.Name = ""
+		}
+		spec.DeprecatedRelease = nil
+		spec.DeprecatedPinned = nil
+		spec.DeprecatedRunLatest = runLatest
+	}
+	return target, nil
+}
+
+// HasRevisionTraffic returns whether some traffic of the given service is
+// routed to specific revisions or tagged, which isn't kept by CopyService
+func HasRevisionTraffic(service *servingv1alpha1.Service) bool {
+	spec := service.Spec
+	if spec.DeprecatedRelease != nil || spec.DeprecatedPinned != nil {
+		return true
+	}
+	for _, target := range spec.Traffic {
+		if target.RevisionName != "" || target.Tag != "" || target.DeprecatedName != "" {
+			return true
+		}
+	}
+	return false
+}
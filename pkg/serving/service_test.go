@@ -0,0 +1,110 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"testing"
+
+	servingv1alpha1 "github.com/knative/serving/pkg/apis/serving/v1alpha1"
+	"github.com/knative/serving/pkg/apis/serving/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCopyServiceRelease(t *testing.T) {
+	template, _ := getV1alpha1RevisionTemplateWithOldFields()
+	template.Name = "foo-v1"
+	service := &servingv1alpha1.Service{
+		ObjectMeta: v1.ObjectMeta{Name: "foo", Namespace: "staging", ResourceVersion: "1"},
+		Spec: servingv1alpha1.ServiceSpec{
+			DeprecatedRelease: &servingv1alpha1.ReleaseType{
+				Revisions: []string{"foo-v1"},
+				Configuration: servingv1alpha1.ConfigurationSpec{
+					DeprecatedRevisionTemplate: template,
+				},
+			},
+		},
+	}
+
+	copied, err := CopyService(service, "prod", "bar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if copied.Namespace != "prod" || copied.Name != "bar" || copied.ResourceVersion != "" {
+		t.Fatalf("wrong metadata %v", copied.ObjectMeta)
+	}
+	if copied.Spec.DeprecatedRelease != nil || copied.Spec.DeprecatedRunLatest == nil {
+		t.Fatalf("release not converted to run latest: %v", copied.Spec)
+	}
+	if copied.Spec.DeprecatedRunLatest.Configuration.DeprecatedRevisionTemplate.Name != "" {
+		t.Fatal("revision name not stripped")
+	}
+	if template.Name != "foo-v1" || service.Spec.DeprecatedRelease == nil {
+		t.Fatal("original service modified")
+	}
+}
+
+func TestCopyServiceTraffic(t *testing.T) {
+	template, _ := getV1alpha1Config()
+	template.Name = "foo-v1"
+	service := &servingv1alpha1.Service{
+		ObjectMeta: v1.ObjectMeta{Name: "foo", Namespace: "staging"},
+		Spec: servingv1alpha1.ServiceSpec{
+			ConfigurationSpec: servingv1alpha1.ConfigurationSpec{Template: template},
+			RouteSpec: servingv1alpha1.RouteSpec{
+				Traffic: []servingv1alpha1.TrafficTarget{
+					{TrafficTarget: v1beta1.TrafficTarget{RevisionName: "foo-v1", Percent: 100}},
+				},
+			},
+		},
+	}
+
+	copied, err := CopyService(service, "prod", "foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if copied.Spec.Template.Name != "" || copied.Spec.Traffic != nil {
+		t.Fatalf("revision references not stripped: %v", copied.Spec)
+	}
+}
+
+func TestHasRevisionTraffic(t *testing.T) {
+	latest := true
+	for _, tc := range []struct {
+		name     string
+		spec     servingv1alpha1.ServiceSpec
+		expected bool
+	}{
+		{"no traffic", servingv1alpha1.ServiceSpec{}, false},
+		{"latest revision", servingv1alpha1.ServiceSpec{RouteSpec: servingv1alpha1.RouteSpec{
+			Traffic: []servingv1alpha1.TrafficTarget{
+				{TrafficTarget: v1beta1.TrafficTarget{LatestRevision: &latest, Percent: 100}},
+			}}}, false},
+		{"tagged latest revision", servingv1alpha1.ServiceSpec{RouteSpec: servingv1alpha1.RouteSpec{
+			Traffic: []servingv1alpha1.TrafficTarget{
+				{TrafficTarget: v1beta1.TrafficTarget{LatestRevision: &latest, Tag: "current", Percent: 100}},
+			}}}, true},
+		{"revision split", servingv1alpha1.ServiceSpec{RouteSpec: servingv1alpha1.RouteSpec{
+			Traffic: []servingv1alpha1.TrafficTarget{
+				{TrafficTarget: v1beta1.TrafficTarget{RevisionName: "foo-v1", Percent: 50}},
+				{TrafficTarget: v1beta1.TrafficTarget{LatestRevision: &latest, Percent: 50}},
+			}}}, true},
+		{"release", servingv1alpha1.ServiceSpec{DeprecatedRelease: &servingv1alpha1.ReleaseType{Revisions: []string{"foo-v1"}}}, true},
+	} {
+		service := &servingv1alpha1.Service{Spec: tc.spec}
+		if HasRevisionTraffic(service) != tc.expected {
+			t.Errorf("%s: expected %v", tc.name, tc.expected)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	servingv1alpha1 "github.com/knative/serving/pkg/apis/serving/v1alpha1"
+)
+
+// Kinds of traffic targets, as used in the 'KIND:NAME' references of
+// traffic and tag specifications
+const (
+	TrafficTargetConfiguration = "configuration"
+	TrafficTargetRevision      = "revision"
+)
+
+// Parse traffic specifications of the form 'KIND:NAME=PERCENT', with KIND
+// being 'configuration' or 'revision', into traffic targets. A target may
+// be given only once.
+func ParseTrafficTargets(specs []string) ([]servingv1alpha1.TrafficTarget, error) {
+	targets := make([]servingv1alpha1.TrafficTarget, 0, len(specs))
+	for _, spec := range specs {
+		kind, name, value, err := parseTargetSpec(spec)
+		if err != nil {
+			return nil, err
+		}
+		if findTrafficTarget(targets, kind, name) >= 0 {
+			return nil, fmt.Errorf("traffic target '%s:%s' given more than once", kind, name)
+		}
+		percent, err := strconv.Atoi(value)
+		if err != nil || percent < 0 || percent > 100 {
+			return nil, fmt.Errorf("invalid traffic percentage '%s' of '%s:%s', expected a number between 0 and 100", value, kind, name)
+		}
+		target := newTrafficTarget(kind, name)
+		target.Percent = percent
+		targets = append(targets, target)
+	}
+	return targets, nil
+}
+
+// Tag traffic targets according to specifications of the form
+// 'KIND:NAME=TAG'. A target which doesn't receive traffic yet, or which is
+// tagged already, is added with 0 percent of the traffic for the tag.
+func UpdateTrafficTags(targets []servingv1alpha1.TrafficTarget, specs []string) ([]servingv1alpha1.TrafficTarget, error) {
+	tags := map[string]bool{}
+	for _, target := range targets {
+		if target.Tag != "" {
+			tags[target.Tag] = true
+		}
+	}
+	for _, spec := range specs {
+		kind, name, tag, err := parseTargetSpec(spec)
+		if err != nil {
+			return nil, err
+		}
+		if tags[tag] {
+			return nil, fmt.Errorf("tag '%s' given more than once", tag)
+		}
+		tags[tag] = true
+		if i := findTrafficTarget(targets, kind, name); i >= 0 && targets[i].Tag == "" {
+			targets[i].Tag = tag
+			continue
+		}
+		target := newTrafficTarget(kind, name)
+		target.Tag = tag
+		targets = append(targets, target)
+	}
+	return targets, nil
+}
+
+// Check that the traffic targets share all of the traffic
+func ValidateTrafficPercent(targets []servingv1alpha1.TrafficTarget) error {
+	sum := 0
+	for _, target := range targets {
+		sum += target.Percent
+	}
+	if sum != 100 {
+		return fmt.Errorf("traffic percentages sum up to %d, expected 100", sum)
+	}
+	return nil
+}
+
+// Get the kind and the name of the configuration or revision a traffic
+// target points to
+func TrafficTargetReference(target servingv1alpha1.TrafficTarget) (kind string, name string) {
+	if target.RevisionName != "" {
+		return TrafficTargetRevision, target.RevisionName
+	}
+	return TrafficTargetConfiguration, target.ConfigurationName
+}
+
+// =======================================================================================
+
+func parseTargetSpec(spec string) (kind, name, value string, err error) {
+	parts := strings.SplitN(spec, ":", 2)
+	if len(parts) == 2 {
+		kind = parts[0]
+		if i := strings.LastIndex(parts[1], "="); i >= 0 {
+			name, value = parts[1][:i], parts[1][i+1:]
+		}
+	}
+	if (kind != TrafficTargetConfiguration && kind != TrafficTargetRevision) || name == "" || value == "" {
+		return "", "", "", fmt.Errorf("invalid traffic target '%s', expected 'configuration:NAME=VALUE' or 'revision:NAME=VALUE'", spec)
+	}
+	return kind, name, value, nil
+}
+
+func findTrafficTarget(targets []servingv1alpha1.TrafficTarget, kind, name string) int {
+	for i, target := range targets {
+		targetKind, targetName := TrafficTargetReference(target)
+		if targetKind == kind && targetName == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func newTrafficTarget(kind, name string) servingv1alpha1.TrafficTarget {
+	target := servingv1alpha1.TrafficTarget{}
+	if kind == TrafficTargetRevision {
+		target.RevisionName = name
+	} else {
+		target.ConfigurationName = name
+	}
+	return target
+}
@@ -0,0 +1,104 @@
+// Copyright © 2019 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"reflect"
+	"testing"
+
+	servingv1alpha1 "github.com/knative/serving/pkg/apis/serving/v1alpha1"
+	"github.com/knative/serving/pkg/apis/serving/v1beta1"
+)
+
+func newTestTrafficTarget(configuration, revision, tag string, percent int) servingv1alpha1.TrafficTarget {
+	return servingv1alpha1.TrafficTarget{
+		TrafficTarget: v1beta1.TrafficTarget{
+			ConfigurationName: configuration,
+			RevisionName:      revision,
+			Tag:               tag,
+			Percent:           percent,
+		},
+	}
+}
+
+func TestParseTrafficTargets(t *testing.T) {
+	targets, err := ParseTrafficTargets([]string{"configuration:a=90", "revision:b-00001=10"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []servingv1alpha1.TrafficTarget{
+		newTestTrafficTarget("a", "", "", 90),
+		newTestTrafficTarget("", "b-00001", "", 10),
+	}
+	if !reflect.DeepEqual(targets, expected) {
+		t.Errorf("wrong targets %v, expected %v", targets, expected)
+	}
+	if err := ValidateTrafficPercent(targets); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestParseTrafficTargetsInvalid(t *testing.T) {
+	for _, specs := range [][]string{
+		{"a=100"},
+		{"service:a=100"},
+		{"configuration:a"},
+		{"configuration:=100"},
+		{"configuration:a=x"},
+		{"configuration:a=101"},
+		{"configuration:a=50", "configuration:a=50"},
+	} {
+		_, err := ParseTrafficTargets(specs)
+		if err == nil {
+			t.Errorf("expected error for %v", specs)
+		}
+	}
+}
+
+func TestUpdateTrafficTags(t *testing.T) {
+	targets := []servingv1alpha1.TrafficTarget{
+		newTestTrafficTarget("a", "", "", 50),
+		newTestTrafficTarget("", "a-00001", "old", 50),
+	}
+	targets, err := UpdateTrafficTags(targets, []string{"configuration:a=latest", "revision:a-00001=v1", "revision:a-00002=next"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []servingv1alpha1.TrafficTarget{
+		newTestTrafficTarget("a", "", "latest", 50),
+		newTestTrafficTarget("", "a-00001", "old", 50),
+		newTestTrafficTarget("", "a-00001", "v1", 0),
+		newTestTrafficTarget("", "a-00002", "next", 0),
+	}
+	if !reflect.DeepEqual(targets, expected) {
+		t.Errorf("wrong targets %v, expected %v", targets, expected)
+	}
+
+	_, err = UpdateTrafficTags(targets, []string{"revision:a-00002=old"})
+	if err == nil || err.Error() != "tag 'old' given more than once" {
+		t.Errorf("expected error for duplicate tag, got %v", err)
+	}
+}
+
+func TestValidateTrafficPercent(t *testing.T) {
+	targets := []servingv1alpha1.TrafficTarget{
+		newTestTrafficTarget("a", "", "", 50),
+		newTestTrafficTarget("b", "", "", 40),
+	}
+	err := ValidateTrafficPercent(targets)
+	if err == nil || err.Error() != "traffic percentages sum up to 90, expected 100" {
+		t.Errorf("expected error for wrong sum, got %v", err)
+	}
+}